@@ -1,4 +1,4 @@
-// Copyright © 2022 Attestant Limited.
+// Copyright © 2022, 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -36,15 +36,17 @@ func NewGinLogger(log zerolog.Logger) gin.HandlerFunc {
 			path = path + "?" + raw
 		}
 
+		requestLog := WithRequestID(c.Request.Context(), log)
+
 		var e *zerolog.Event
 
 		switch {
 		case c.Writer.Status() >= 400 && c.Writer.Status() < 500:
-			e = log.Warn()
+			e = requestLog.Warn()
 		case c.Writer.Status() >= 500 && c.Writer.Status() < 600:
-			e = log.Error()
+			e = requestLog.Error()
 		default:
-			e = log.Trace()
+			e = requestLog.Trace()
 		}
 
 		e.
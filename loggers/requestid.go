@@ -0,0 +1,47 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loggers
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+)
+
+type requestIDContextKey struct{}
+
+// ContextWithRequestID returns a copy of the context carrying the request ID.
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, requestID)
+}
+
+// RequestID returns the request ID carried by the context, or an empty string if there is none.
+func RequestID(ctx context.Context) string {
+	requestID, ok := ctx.Value(requestIDContextKey{}).(string)
+	if !ok {
+		return ""
+	}
+
+	return requestID
+}
+
+// WithRequestID returns the logger with the request ID carried by the context, if any.
+func WithRequestID(ctx context.Context, log zerolog.Logger) zerolog.Logger {
+	requestID := RequestID(ctx)
+	if requestID == "" {
+		return log
+	}
+
+	return log.With().Str("request_id", requestID).Logger()
+}
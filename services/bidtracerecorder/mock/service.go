@@ -0,0 +1,81 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-block-relay/services/bidtracerecorder"
+	"github.com/attestantio/go-block-relay/types"
+	"github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Service is a mock bid trace recorder.
+type Service struct{}
+
+// New creates a new mock bid trace recorder.
+func New() *Service {
+	return &Service{}
+}
+
+// RecordServedBid records a bid served to a proposer.
+func (s *Service) RecordServedBid(_ context.Context,
+	_ phase0.Slot,
+	_ phase0.BLSPubKey,
+	_ phase0.BLSPubKey,
+	_ *spec.VersionedSignedBuilderBid,
+) error {
+	return nil
+}
+
+// RecordDeliveredPayload records the delivery of the payload for a previously served bid.
+func (s *Service) RecordDeliveredPayload(_ context.Context,
+	_ *api.VersionedSignedProposal,
+) error {
+	return nil
+}
+
+// DeliveredPayloads provides traces of delivered payloads matching the filter.
+func (s *Service) DeliveredPayloads(_ context.Context,
+	_ *bidtracerecorder.DeliveredPayloadsFilter,
+) (
+	[]*types.BidTrace,
+	error,
+) {
+	return []*types.BidTrace{}, nil
+}
+
+// RecordReceivedBid records a bid received from a builder.
+func (s *Service) RecordReceivedBid(_ context.Context,
+	_ phase0.Slot,
+	_ phase0.BLSPubKey,
+	_ *spec.VersionedSignedBuilderBid,
+	_ time.Time,
+	_ bool,
+) error {
+	return nil
+}
+
+// ReceivedBids provides traces of received bids matching the filter, most recently received first.
+func (s *Service) ReceivedBids(_ context.Context,
+	_ *bidtracerecorder.ReceivedBidsFilter,
+) (
+	[]*types.ReceivedBidTrace,
+	error,
+) {
+	return []*types.ReceivedBidTrace{}, nil
+}
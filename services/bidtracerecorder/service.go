@@ -0,0 +1,128 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bidtracerecorder
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-block-relay/types"
+	"github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Order is the order in which bid traces are returned.
+type Order int
+
+const (
+	// OrderSlotDescending returns traces with the highest slot first.
+	OrderSlotDescending Order = iota
+	// OrderValueAscending returns traces with the lowest value first.
+	OrderValueAscending
+	// OrderValueDescending returns traces with the highest value first.
+	OrderValueDescending
+)
+
+// Service defines the bid trace recorder service.
+type Service interface {
+	// RecordServedBid records a bid served to a proposer.
+	// The builder is supplied separately, as the bid may be signed by the relay on the builder's behalf.
+	RecordServedBid(ctx context.Context,
+		slot phase0.Slot,
+		proposerPubkey phase0.BLSPubKey,
+		builderPubkey phase0.BLSPubKey,
+		bid *spec.VersionedSignedBuilderBid,
+	) error
+
+	// RecordDeliveredPayload records the delivery of the payload for a previously served bid.
+	RecordDeliveredPayload(ctx context.Context,
+		proposal *api.VersionedSignedProposal,
+	) error
+}
+
+// MaxQueryLimit is the maximum number of traces returned by a single query.
+const MaxQueryLimit = 200
+
+// DeliveredPayloadsFilter selects traces of delivered payloads.
+// Fields that are not set do not restrict the selection.
+type DeliveredPayloadsFilter struct {
+	// Slot selects traces for the given slot.
+	Slot *phase0.Slot
+	// Cursor selects traces for the given slot and earlier.
+	Cursor *phase0.Slot
+	// BlockHash selects traces for the given block hash.
+	BlockHash *phase0.Hash32
+	// BlockNumber selects traces for the given block number.
+	BlockNumber *uint64
+	// ProposerPubkey selects traces for the given proposer.
+	ProposerPubkey *phase0.BLSPubKey
+	// BuilderPubkey selects traces for the given builder.
+	BuilderPubkey *phase0.BLSPubKey
+	// Limit is the maximum number of traces to return.
+	// If 0, or greater than MaxQueryLimit, MaxQueryLimit is used.
+	Limit int
+	// Order is the order in which traces are returned.
+	Order Order
+}
+
+// DeliveredPayloadProvider is the interface for providing traces of delivered payloads.
+type DeliveredPayloadProvider interface {
+	// DeliveredPayloads provides traces of delivered payloads matching the filter.
+	DeliveredPayloads(ctx context.Context,
+		filter *DeliveredPayloadsFilter,
+	) (
+		[]*types.BidTrace,
+		error,
+	)
+}
+
+// ReceivedBidRecorder is the interface for recording bids received from builders.
+type ReceivedBidRecorder interface {
+	// RecordReceivedBid records a bid received from a builder.
+	RecordReceivedBid(ctx context.Context,
+		slot phase0.Slot,
+		proposerPubkey phase0.BLSPubKey,
+		bid *spec.VersionedSignedBuilderBid,
+		received time.Time,
+		optimistic bool,
+	) error
+}
+
+// ReceivedBidsFilter selects traces of received bids.
+// Fields that are not set do not restrict the selection.
+type ReceivedBidsFilter struct {
+	// Slot selects traces for the given slot.
+	Slot *phase0.Slot
+	// BlockHash selects traces for the given block hash.
+	BlockHash *phase0.Hash32
+	// BlockNumber selects traces for the given block number.
+	BlockNumber *uint64
+	// BuilderPubkey selects traces for the given builder.
+	BuilderPubkey *phase0.BLSPubKey
+	// Limit is the maximum number of traces to return.
+	// If 0, or greater than MaxQueryLimit, MaxQueryLimit is used.
+	Limit int
+}
+
+// ReceivedBidProvider is the interface for providing traces of received bids.
+type ReceivedBidProvider interface {
+	// ReceivedBids provides traces of received bids matching the filter, most recently received first.
+	ReceivedBids(ctx context.Context,
+		filter *ReceivedBidsFilter,
+	) (
+		[]*types.ReceivedBidTrace,
+		error,
+	)
+}
@@ -0,0 +1,98 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"errors"
+
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel             zerolog.Level
+	retentionSlots       uint64
+	maxDeliveredPayloads int
+	maxReceivedBids      int
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithRetentionSlots sets the number of slots for which served bids are retained awaiting delivery.
+func WithRetentionSlots(slots uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retentionSlots = slots
+	})
+}
+
+// WithMaxDeliveredPayloads sets the maximum number of delivered payload traces retained.
+// When the maximum is reached the oldest traces are discarded.
+func WithMaxDeliveredPayloads(maxDeliveredPayloads int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxDeliveredPayloads = maxDeliveredPayloads
+	})
+}
+
+// WithMaxReceivedBids sets the maximum number of received bid traces retained.
+// When the maximum is reached the oldest traces are discarded.
+func WithMaxReceivedBids(maxReceivedBids int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxReceivedBids = maxReceivedBids
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:             zerolog.GlobalLevel(),
+		retentionSlots:       64,
+		maxDeliveredPayloads: 100000,
+		maxReceivedBids:      100000,
+	}
+
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.retentionSlots == 0 {
+		return nil, errors.New("retention slots must be greater than 0")
+	}
+
+	if parameters.maxDeliveredPayloads <= 0 {
+		return nil, errors.New("max delivered payloads must be greater than 0")
+	}
+
+	if parameters.maxReceivedBids <= 0 {
+		return nil, errors.New("max received bids must be greater than 0")
+	}
+
+	return &parameters, nil
+}
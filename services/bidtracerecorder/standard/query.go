@@ -0,0 +1,144 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"sort"
+
+	"github.com/attestantio/go-block-relay/services/bidtracerecorder"
+	"github.com/attestantio/go-block-relay/types"
+	"github.com/pkg/errors"
+)
+
+// DeliveredPayloads provides traces of delivered payloads matching the filter.
+func (s *Service) DeliveredPayloads(_ context.Context,
+	filter *bidtracerecorder.DeliveredPayloadsFilter,
+) (
+	[]*types.BidTrace,
+	error,
+) {
+	if filter == nil {
+		return nil, errors.New("no filter supplied")
+	}
+
+	s.deliveredMu.RLock()
+	traces := make([]*types.BidTrace, 0)
+	for _, trace := range s.delivered {
+		if matchesDeliveredPayloadsFilter(trace, filter) {
+			traces = append(traces, trace)
+		}
+	}
+	s.deliveredMu.RUnlock()
+
+	switch filter.Order {
+	case bidtracerecorder.OrderValueAscending:
+		sort.SliceStable(traces, func(i int, j int) bool {
+			return traces[i].Value.Cmp(traces[j].Value) < 0
+		})
+	case bidtracerecorder.OrderValueDescending:
+		sort.SliceStable(traces, func(i int, j int) bool {
+			return traces[i].Value.Cmp(traces[j].Value) > 0
+		})
+	default:
+		sort.SliceStable(traces, func(i int, j int) bool {
+			return traces[i].Slot > traces[j].Slot
+		})
+	}
+
+	if limit := queryLimit(filter.Limit); len(traces) > limit {
+		traces = traces[:limit]
+	}
+
+	return traces, nil
+}
+
+func matchesDeliveredPayloadsFilter(trace *types.BidTrace,
+	filter *bidtracerecorder.DeliveredPayloadsFilter,
+) bool {
+	switch {
+	case filter.Slot != nil && trace.Slot != *filter.Slot:
+		return false
+	case filter.Cursor != nil && trace.Slot > *filter.Cursor:
+		return false
+	case filter.BlockHash != nil && trace.BlockHash != *filter.BlockHash:
+		return false
+	case filter.BlockNumber != nil && trace.BlockNumber != *filter.BlockNumber:
+		return false
+	case filter.ProposerPubkey != nil && trace.ProposerPubkey != *filter.ProposerPubkey:
+		return false
+	case filter.BuilderPubkey != nil && trace.BuilderPubkey != *filter.BuilderPubkey:
+		return false
+	default:
+		return true
+	}
+}
+
+// ReceivedBids provides traces of received bids matching the filter, most recently received first.
+func (s *Service) ReceivedBids(_ context.Context,
+	filter *bidtracerecorder.ReceivedBidsFilter,
+) (
+	[]*types.ReceivedBidTrace,
+	error,
+) {
+	if filter == nil {
+		return nil, errors.New("no filter supplied")
+	}
+
+	s.receivedMu.RLock()
+	traces := make([]*types.ReceivedBidTrace, 0)
+	for i := len(s.received) - 1; i >= 0; i-- {
+		if matchesReceivedBidsFilter(s.received[i], filter) {
+			traces = append(traces, s.received[i])
+		}
+	}
+	s.receivedMu.RUnlock()
+
+	// Bids from concurrent requests may be recorded slightly out of order.
+	sort.SliceStable(traces, func(i int, j int) bool {
+		return traces[i].Timestamp.After(traces[j].Timestamp)
+	})
+
+	if limit := queryLimit(filter.Limit); len(traces) > limit {
+		traces = traces[:limit]
+	}
+
+	return traces, nil
+}
+
+func matchesReceivedBidsFilter(trace *types.ReceivedBidTrace,
+	filter *bidtracerecorder.ReceivedBidsFilter,
+) bool {
+	switch {
+	case filter.Slot != nil && trace.Slot != *filter.Slot:
+		return false
+	case filter.BlockHash != nil && trace.BlockHash != *filter.BlockHash:
+		return false
+	case filter.BlockNumber != nil && trace.BlockNumber != *filter.BlockNumber:
+		return false
+	case filter.BuilderPubkey != nil && trace.BuilderPubkey != *filter.BuilderPubkey:
+		return false
+	default:
+		return true
+	}
+}
+
+// queryLimit provides the number of traces to return for the given filter limit.
+func queryLimit(limit int) int {
+	if limit <= 0 || limit > bidtracerecorder.MaxQueryLimit {
+		return bidtracerecorder.MaxQueryLimit
+	}
+
+	return limit
+}
@@ -0,0 +1,274 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/attestantio/go-block-relay/loggers"
+	"github.com/attestantio/go-block-relay/types"
+	"github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/go-eth2-client/api"
+	consensusspec "github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// RecordServedBid records a bid served to a proposer.
+func (s *Service) RecordServedBid(ctx context.Context,
+	slot phase0.Slot,
+	proposerPubkey phase0.BLSPubKey,
+	builderPubkey phase0.BLSPubKey,
+	bid *spec.VersionedSignedBuilderBid,
+) error {
+	log := loggers.WithRequestID(ctx, s.log)
+
+	if bid == nil {
+		return errors.New("no bid supplied")
+	}
+
+	trace, err := bidTrace(slot, proposerPubkey, builderPubkey, bid)
+	if err != nil {
+		return err
+	}
+
+	s.servedMu.Lock()
+	s.served[trace.BlockHash] = trace
+	if slot > s.highestSlot {
+		s.highestSlot = slot
+		s.prune(ctx)
+	}
+	s.servedMu.Unlock()
+
+	log.Trace().Uint64("slot", uint64(slot)).Stringer("block_hash", trace.BlockHash).Msg("Recorded served bid")
+
+	return nil
+}
+
+// RecordDeliveredPayload records the delivery of the payload for a previously served bid.
+func (s *Service) RecordDeliveredPayload(ctx context.Context,
+	proposal *api.VersionedSignedProposal,
+) error {
+	log := loggers.WithRequestID(ctx, s.log)
+
+	if proposal == nil {
+		return errors.New("no proposal supplied")
+	}
+
+	blockHash, err := proposal.ExecutionBlockHash()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain block hash")
+	}
+
+	numTx, err := proposalTransactions(proposal)
+	if err != nil {
+		return err
+	}
+
+	s.servedMu.Lock()
+	served, exists := s.served[blockHash]
+	s.servedMu.Unlock()
+	if !exists {
+		return fmt.Errorf("no served bid for block hash %#x", blockHash)
+	}
+
+	trace := *served
+	trace.NumTx = numTx
+
+	s.deliveredMu.Lock()
+	defer s.deliveredMu.Unlock()
+
+	if _, exists := s.deliveredByBlockHash[blockHash]; exists {
+		// Repeated delivery of the same payload, for example a retry by the proposer.
+		return nil
+	}
+
+	s.delivered = append(s.delivered, &trace)
+	s.deliveredByBlockHash[blockHash] = &trace
+	if len(s.delivered) > s.maxDeliveredPayloads {
+		evicted := len(s.delivered) - s.maxDeliveredPayloads
+		for _, delivered := range s.delivered[:evicted] {
+			delete(s.deliveredByBlockHash, delivered.BlockHash)
+		}
+		s.delivered = s.delivered[evicted:]
+	}
+
+	log.Trace().Uint64("slot", uint64(trace.Slot)).Stringer("block_hash", blockHash).Msg("Recorded delivered payload")
+
+	return nil
+}
+
+// RecordReceivedBid records a bid received from a builder.
+func (s *Service) RecordReceivedBid(ctx context.Context,
+	slot phase0.Slot,
+	proposerPubkey phase0.BLSPubKey,
+	bid *spec.VersionedSignedBuilderBid,
+	received time.Time,
+	optimistic bool,
+) error {
+	log := loggers.WithRequestID(ctx, s.log)
+
+	if bid == nil {
+		return errors.New("no bid supplied")
+	}
+
+	// Received bids are signed by the builder that created them.
+	builderPubkey, err := bid.Builder()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain builder")
+	}
+
+	trace, err := bidTrace(slot, proposerPubkey, builderPubkey, bid)
+	if err != nil {
+		return err
+	}
+
+	s.receivedMu.Lock()
+	s.received = append(s.received, &types.ReceivedBidTrace{
+		BidTrace:             *trace,
+		Timestamp:            received,
+		OptimisticSubmission: optimistic,
+	})
+	if len(s.received) > s.maxReceivedBids {
+		s.received = s.received[len(s.received)-s.maxReceivedBids:]
+	}
+	s.receivedMu.Unlock()
+
+	log.Trace().Uint64("slot", uint64(slot)).Stringer("block_hash", trace.BlockHash).Msg("Recorded received bid")
+
+	return nil
+}
+
+// prune removes served bids that are outside of the retention period.
+// This must be called with the served lock held.
+func (s *Service) prune(ctx context.Context) {
+	if uint64(s.highestSlot) < s.retentionSlots {
+		return
+	}
+
+	minSlot := s.highestSlot - phase0.Slot(s.retentionSlots)
+	pruned := 0
+	for blockHash, trace := range s.served {
+		if trace.Slot < minSlot {
+			delete(s.served, blockHash)
+			pruned++
+		}
+	}
+
+	if pruned > 0 {
+		log := loggers.WithRequestID(ctx, s.log)
+		log.Trace().Uint64("min_slot", uint64(minSlot)).Int("pruned", pruned).Msg("Pruned served bids")
+	}
+}
+
+// bidTrace creates the trace for a bid.
+func bidTrace(slot phase0.Slot,
+	proposerPubkey phase0.BLSPubKey,
+	builderPubkey phase0.BLSPubKey,
+	bid *spec.VersionedSignedBuilderBid,
+) (
+	*types.BidTrace,
+	error,
+) {
+	if bid.IsEmpty() {
+		return nil, errors.New("bid is empty")
+	}
+
+	trace := &types.BidTrace{
+		Slot:           slot,
+		ProposerPubkey: proposerPubkey,
+		BuilderPubkey:  builderPubkey,
+	}
+
+	var err error
+
+	trace.ParentHash, err = bid.ParentHash()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain parent hash")
+	}
+
+	trace.BlockHash, err = bid.BlockHash()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block hash")
+	}
+
+	trace.ProposerFeeRecipient, err = bid.FeeRecipient()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain fee recipient")
+	}
+
+	trace.GasLimit, err = bid.BlockGasLimit()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain gas limit")
+	}
+
+	trace.GasUsed, err = bidGasUsed(bid)
+	if err != nil {
+		return nil, err
+	}
+
+	trace.Value, err = bid.Value()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain value")
+	}
+
+	trace.BlockNumber, err = bid.BlockNumber()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain block number")
+	}
+
+	return trace, nil
+}
+
+// bidGasUsed returns the gas used by the block behind a bid.
+func bidGasUsed(bid *spec.VersionedSignedBuilderBid) (uint64, error) {
+	switch bid.Version {
+	case consensusspec.DataVersionBellatrix:
+		return bid.Bellatrix.Message.Header.GasUsed, nil
+	case consensusspec.DataVersionCapella:
+		return bid.Capella.Message.Header.GasUsed, nil
+	case consensusspec.DataVersionDeneb:
+		return bid.Deneb.Message.Header.GasUsed, nil
+	case consensusspec.DataVersionElectra:
+		return bid.Electra.Message.Header.GasUsed, nil
+	case consensusspec.DataVersionFulu:
+		return bid.Fulu.Message.Header.GasUsed, nil
+	default:
+		return 0, fmt.Errorf("unsupported bid version %v", bid.Version)
+	}
+}
+
+// proposalTransactions returns the number of transactions in an unblinded proposal.
+func proposalTransactions(proposal *api.VersionedSignedProposal) (uint64, error) {
+	if proposal.Blinded {
+		return 0, errors.New("proposal is blinded")
+	}
+
+	switch proposal.Version {
+	case consensusspec.DataVersionBellatrix:
+		return uint64(len(proposal.Bellatrix.Message.Body.ExecutionPayload.Transactions)), nil
+	case consensusspec.DataVersionCapella:
+		return uint64(len(proposal.Capella.Message.Body.ExecutionPayload.Transactions)), nil
+	case consensusspec.DataVersionDeneb:
+		return uint64(len(proposal.Deneb.SignedBlock.Message.Body.ExecutionPayload.Transactions)), nil
+	case consensusspec.DataVersionElectra:
+		return uint64(len(proposal.Electra.SignedBlock.Message.Body.ExecutionPayload.Transactions)), nil
+	case consensusspec.DataVersionFulu:
+		return uint64(len(proposal.Fulu.SignedBlock.Message.Body.ExecutionPayload.Transactions)), nil
+	default:
+		return 0, fmt.Errorf("unsupported proposal version %v", proposal.Version)
+	}
+}
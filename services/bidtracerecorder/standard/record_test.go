@@ -0,0 +1,425 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-block-relay/services/bidtracerecorder"
+	"github.com/attestantio/go-block-relay/services/bidtracerecorder/standard"
+	builderelectra "github.com/attestantio/go-builder-client/api/electra"
+	builderspec "github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// testBid creates a bid for the given block hash, builder and value.
+func testBid(blockHash byte, builder byte, value uint64) *builderspec.VersionedSignedBuilderBid {
+	return &builderspec.VersionedSignedBuilderBid{
+		Version: spec.DataVersionElectra,
+		Electra: &builderelectra.SignedBuilderBid{
+			Message: &builderelectra.BuilderBid{
+				Header: &deneb.ExecutionPayloadHeader{
+					ParentHash:    phase0.Hash32{0x01},
+					FeeRecipient:  bellatrix.ExecutionAddress{0x02},
+					BlockNumber:   uint64(blockHash),
+					GasLimit:      30000000,
+					GasUsed:       21000,
+					BaseFeePerGas: uint256.NewInt(7),
+					BlockHash:     phase0.Hash32{blockHash},
+				},
+				BlobKZGCommitments: make([]deneb.KZGCommitment, 0),
+				ExecutionRequests:  &electra.ExecutionRequests{},
+				Value:              uint256.NewInt(value),
+				Pubkey:             phase0.BLSPubKey{builder},
+			},
+		},
+	}
+}
+
+// testProposal creates an unblinded proposal for the given block hash.
+func testProposal(blockHash byte) *api.VersionedSignedProposal {
+	return &api.VersionedSignedProposal{
+		Version: spec.DataVersionElectra,
+		Electra: &apiv1electra.SignedBlockContents{
+			SignedBlock: &electra.SignedBeaconBlock{
+				Message: &electra.BeaconBlock{
+					Body: &electra.BeaconBlockBody{
+						ExecutionPayload: &deneb.ExecutionPayload{
+							BlockHash:    phase0.Hash32{blockHash},
+							Transactions: []bellatrix.Transaction{{0x01}, {0x02}, {0x03}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestRecordDeliveredPayload(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+	)
+	require.NoError(t, err)
+
+	// Unknown payload.
+	require.EqualError(t, s.RecordDeliveredPayload(ctx, testProposal(0x10)),
+		"no served bid for block hash 0x1000000000000000000000000000000000000000000000000000000000000000")
+
+	// The builder is recorded as supplied, rather than as the signer of the bid.
+	require.NoError(t, s.RecordServedBid(ctx, 5, phase0.BLSPubKey{0x05}, phase0.BLSPubKey{0x30}, testBid(0x10, 0x20, 1000)))
+	require.NoError(t, s.RecordDeliveredPayload(ctx, testProposal(0x10)))
+
+	// Repeated delivery is recorded once.
+	require.NoError(t, s.RecordDeliveredPayload(ctx, testProposal(0x10)))
+
+	traces, err := s.DeliveredPayloads(ctx, &bidtracerecorder.DeliveredPayloadsFilter{})
+	require.NoError(t, err)
+	require.Len(t, traces, 1)
+	require.Equal(t, phase0.Slot(5), traces[0].Slot)
+	require.Equal(t, phase0.Hash32{0x01}, traces[0].ParentHash)
+	require.Equal(t, phase0.Hash32{0x10}, traces[0].BlockHash)
+	require.Equal(t, phase0.BLSPubKey{0x30}, traces[0].BuilderPubkey)
+	require.Equal(t, phase0.BLSPubKey{0x05}, traces[0].ProposerPubkey)
+	require.Equal(t, bellatrix.ExecutionAddress{0x02}, traces[0].ProposerFeeRecipient)
+	require.Equal(t, uint64(30000000), traces[0].GasLimit)
+	require.Equal(t, uint64(21000), traces[0].GasUsed)
+	require.Equal(t, uint64(1000), traces[0].Value.Uint64())
+	require.Equal(t, uint64(0x10), traces[0].BlockNumber)
+	require.Equal(t, uint64(3), traces[0].NumTx)
+}
+
+func TestRecordDeliveredPayloadPruned(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithRetentionSlots(2),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, s.RecordServedBid(ctx, 1, phase0.BLSPubKey{0x01}, phase0.BLSPubKey{0x20}, testBid(0x10, 0x20, 1000)))
+	require.NoError(t, s.RecordServedBid(ctx, 5, phase0.BLSPubKey{0x01}, phase0.BLSPubKey{0x20}, testBid(0x11, 0x20, 1000)))
+
+	require.EqualError(t, s.RecordDeliveredPayload(ctx, testProposal(0x10)),
+		"no served bid for block hash 0x1000000000000000000000000000000000000000000000000000000000000000")
+	require.NoError(t, s.RecordDeliveredPayload(ctx, testProposal(0x11)))
+}
+
+func TestRecordDeliveredPayloadEvicted(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithMaxDeliveredPayloads(1),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, s.RecordServedBid(ctx, 1, phase0.BLSPubKey{0x01}, phase0.BLSPubKey{0x20}, testBid(0x10, 0x20, 1000)))
+	require.NoError(t, s.RecordServedBid(ctx, 2, phase0.BLSPubKey{0x01}, phase0.BLSPubKey{0x20}, testBid(0x11, 0x20, 1000)))
+	require.NoError(t, s.RecordDeliveredPayload(ctx, testProposal(0x10)))
+	require.NoError(t, s.RecordDeliveredPayload(ctx, testProposal(0x11)))
+
+	// The evicted delivery is no longer known, so is recorded again.
+	require.NoError(t, s.RecordDeliveredPayload(ctx, testProposal(0x10)))
+
+	traces, err := s.DeliveredPayloads(ctx, &bidtracerecorder.DeliveredPayloadsFilter{})
+	require.NoError(t, err)
+	require.Len(t, traces, 1)
+	require.Equal(t, phase0.Hash32{0x10}, traces[0].BlockHash)
+}
+
+func TestDeliveredPayloads(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithMaxDeliveredPayloads(4),
+	)
+	require.NoError(t, err)
+
+	// Slot 1 is discarded when slot 5 is delivered, as only 4 traces are retained.
+	for i, bid := range []struct {
+		slot    phase0.Slot
+		builder byte
+		value   uint64
+	}{
+		{slot: 1, builder: 0x20, value: 5000},
+		{slot: 2, builder: 0x20, value: 3000},
+		{slot: 3, builder: 0x21, value: 1000},
+		{slot: 4, builder: 0x21, value: 4000},
+		{slot: 5, builder: 0x20, value: 2000},
+	} {
+		blockHash := byte(0x10 + i)
+		require.NoError(t, s.RecordServedBid(ctx, bid.slot, phase0.BLSPubKey{byte(bid.slot)}, phase0.BLSPubKey{bid.builder}, testBid(blockHash, bid.builder, bid.value)))
+		require.NoError(t, s.RecordDeliveredPayload(ctx, testProposal(blockHash)))
+	}
+
+	slot := phase0.Slot(3)
+	cursor := phase0.Slot(4)
+	blockHash := phase0.Hash32{0x12}
+	blockNumber := uint64(0x13)
+	proposerPubkey := phase0.BLSPubKey{0x05}
+	builderPubkey := phase0.BLSPubKey{0x20}
+
+	tests := []struct {
+		name   string
+		filter *bidtracerecorder.DeliveredPayloadsFilter
+		slots  []phase0.Slot
+		err    string
+	}{
+		{
+			name: "FilterMissing",
+			err:  "no filter supplied",
+		},
+		{
+			name:   "All",
+			filter: &bidtracerecorder.DeliveredPayloadsFilter{},
+			slots:  []phase0.Slot{5, 4, 3, 2},
+		},
+		{
+			name: "Slot",
+			filter: &bidtracerecorder.DeliveredPayloadsFilter{
+				Slot: &slot,
+			},
+			slots: []phase0.Slot{3},
+		},
+		{
+			name: "Cursor",
+			filter: &bidtracerecorder.DeliveredPayloadsFilter{
+				Cursor: &cursor,
+			},
+			slots: []phase0.Slot{4, 3, 2},
+		},
+		{
+			name: "BlockHash",
+			filter: &bidtracerecorder.DeliveredPayloadsFilter{
+				BlockHash: &blockHash,
+			},
+			slots: []phase0.Slot{3},
+		},
+		{
+			name: "BlockNumber",
+			filter: &bidtracerecorder.DeliveredPayloadsFilter{
+				BlockNumber: &blockNumber,
+			},
+			slots: []phase0.Slot{4},
+		},
+		{
+			name: "ProposerPubkey",
+			filter: &bidtracerecorder.DeliveredPayloadsFilter{
+				ProposerPubkey: &proposerPubkey,
+			},
+			slots: []phase0.Slot{5},
+		},
+		{
+			name: "BuilderPubkey",
+			filter: &bidtracerecorder.DeliveredPayloadsFilter{
+				BuilderPubkey: &builderPubkey,
+			},
+			slots: []phase0.Slot{5, 2},
+		},
+		{
+			name: "Limit",
+			filter: &bidtracerecorder.DeliveredPayloadsFilter{
+				Limit: 2,
+			},
+			slots: []phase0.Slot{5, 4},
+		},
+		{
+			name: "ValueAscending",
+			filter: &bidtracerecorder.DeliveredPayloadsFilter{
+				Order: bidtracerecorder.OrderValueAscending,
+			},
+			slots: []phase0.Slot{3, 5, 2, 4},
+		},
+		{
+			name: "ValueDescending",
+			filter: &bidtracerecorder.DeliveredPayloadsFilter{
+				Order: bidtracerecorder.OrderValueDescending,
+				Limit: 3,
+			},
+			slots: []phase0.Slot{4, 2, 5},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			traces, err := s.DeliveredPayloads(ctx, test.filter)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+
+			slots := make([]phase0.Slot, 0, len(traces))
+			for _, trace := range traces {
+				slots = append(slots, trace.Slot)
+			}
+			require.Equal(t, test.slots, slots)
+		})
+	}
+}
+
+func TestReceivedBids(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithMaxReceivedBids(4),
+	)
+	require.NoError(t, err)
+
+	// The first bid is discarded when the fifth is received, as only 4 traces are retained.
+	// The fourth bid is recorded before the third, but was received after it.
+	// Traces are returned most recently received first.
+	start := time.Unix(1700000000, 0)
+	for i, bid := range []struct {
+		slot       phase0.Slot
+		builder    byte
+		received   time.Duration
+		optimistic bool
+	}{
+		{slot: 1, builder: 0x20, received: 0},
+		{slot: 2, builder: 0x20, received: time.Second},
+		{slot: 2, builder: 0x21, received: 3 * time.Second, optimistic: true},
+		{slot: 2, builder: 0x22, received: 2 * time.Second},
+		{slot: 3, builder: 0x20, received: 4 * time.Second},
+	} {
+		require.NoError(t, s.RecordReceivedBid(ctx,
+			bid.slot,
+			phase0.BLSPubKey{0x05},
+			testBid(byte(0x10+i), bid.builder, 1000),
+			start.Add(bid.received),
+			bid.optimistic,
+		))
+	}
+
+	slot := phase0.Slot(2)
+	blockHash := phase0.Hash32{0x12}
+	blockNumber := uint64(0x13)
+	builderPubkey := phase0.BLSPubKey{0x20}
+
+	tests := []struct {
+		name        string
+		filter      *bidtracerecorder.ReceivedBidsFilter
+		blockHashes []byte
+		err         string
+	}{
+		{
+			name: "FilterMissing",
+			err:  "no filter supplied",
+		},
+		{
+			name:        "All",
+			filter:      &bidtracerecorder.ReceivedBidsFilter{},
+			blockHashes: []byte{0x14, 0x12, 0x13, 0x11},
+		},
+		{
+			name: "Slot",
+			filter: &bidtracerecorder.ReceivedBidsFilter{
+				Slot: &slot,
+			},
+			blockHashes: []byte{0x12, 0x13, 0x11},
+		},
+		{
+			name: "BlockHash",
+			filter: &bidtracerecorder.ReceivedBidsFilter{
+				BlockHash: &blockHash,
+			},
+			blockHashes: []byte{0x12},
+		},
+		{
+			name: "BlockNumber",
+			filter: &bidtracerecorder.ReceivedBidsFilter{
+				BlockNumber: &blockNumber,
+			},
+			blockHashes: []byte{0x13},
+		},
+		{
+			name: "BuilderPubkey",
+			filter: &bidtracerecorder.ReceivedBidsFilter{
+				BuilderPubkey: &builderPubkey,
+			},
+			blockHashes: []byte{0x14, 0x11},
+		},
+		{
+			name: "Limit",
+			filter: &bidtracerecorder.ReceivedBidsFilter{
+				Slot:  &slot,
+				Limit: 2,
+			},
+			blockHashes: []byte{0x12, 0x13},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			traces, err := s.ReceivedBids(ctx, test.filter)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+
+			blockHashes := make([]byte, 0, len(traces))
+			for _, trace := range traces {
+				blockHashes = append(blockHashes, trace.BlockHash[0])
+				require.Equal(t, trace.BlockHash[0] == 0x12, trace.OptimisticSubmission)
+			}
+			require.Equal(t, test.blockHashes, blockHashes)
+		})
+	}
+}
+
+func TestQueryLimitCapped(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+	)
+	require.NoError(t, err)
+
+	received := time.Now()
+	for i := range bidtracerecorder.MaxQueryLimit + 50 {
+		bid := testBid(byte(i), 0x20, uint64(i+1))
+		require.NoError(t, s.RecordServedBid(ctx, phase0.Slot(i), phase0.BLSPubKey{0x05}, phase0.BLSPubKey{0x20}, bid))
+		require.NoError(t, s.RecordDeliveredPayload(ctx, testProposal(byte(i))))
+		require.NoError(t, s.RecordReceivedBid(ctx, phase0.Slot(i), phase0.BLSPubKey{0x05}, bid, received, false))
+	}
+
+	for _, limit := range []int{0, -1, bidtracerecorder.MaxQueryLimit + 1} {
+		deliveredTraces, err := s.DeliveredPayloads(ctx, &bidtracerecorder.DeliveredPayloadsFilter{Limit: limit})
+		require.NoError(t, err)
+		require.Len(t, deliveredTraces, bidtracerecorder.MaxQueryLimit)
+
+		receivedTraces, err := s.ReceivedBids(ctx, &bidtracerecorder.ReceivedBidsFilter{Limit: limit})
+		require.NoError(t, err)
+		require.Len(t, receivedTraces, bidtracerecorder.MaxQueryLimit)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"sync"
+
+	"github.com/attestantio/go-block-relay/types"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a bid trace recorder that holds traces in memory.
+type Service struct {
+	log                  zerolog.Logger
+	retentionSlots       uint64
+	maxDeliveredPayloads int
+	maxReceivedBids      int
+
+	servedMu    sync.Mutex
+	served      map[phase0.Hash32]*types.BidTrace
+	highestSlot phase0.Slot
+
+	deliveredMu          sync.RWMutex
+	delivered            []*types.BidTrace
+	deliveredByBlockHash map[phase0.Hash32]*types.BidTrace
+
+	receivedMu sync.RWMutex
+	received   []*types.ReceivedBidTrace
+}
+
+// New creates a new bid trace recorder.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "bidtracerecorder").Str("impl", "standard").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	s := &Service{
+		log:                  log,
+		retentionSlots:       parameters.retentionSlots,
+		maxDeliveredPayloads: parameters.maxDeliveredPayloads,
+		maxReceivedBids:      parameters.maxReceivedBids,
+		served:               make(map[phase0.Hash32]*types.BidTrace),
+		delivered:            make([]*types.BidTrace, 0),
+		deliveredByBlockHash: make(map[phase0.Hash32]*types.BidTrace),
+		received:             make([]*types.ReceivedBidTrace, 0),
+	}
+
+	return s, nil
+}
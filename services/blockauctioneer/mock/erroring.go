@@ -40,3 +40,8 @@ func (s *ErroringService) AuctionBlock(_ context.Context,
 ) {
 	return nil, errors.New("error")
 }
+
+// Healthy returns true if the service is able to handle requests.
+func (s *ErroringService) Healthy(_ context.Context) bool {
+	return false
+}
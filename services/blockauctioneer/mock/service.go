@@ -44,3 +44,8 @@ func (s *Service) AuctionBlock(_ context.Context,
 		Providers:     make([]builderclient.BuilderBidProvider, 0),
 	}, nil
 }
+
+// Healthy returns true if the service is able to handle requests.
+func (s *Service) Healthy(_ context.Context) bool {
+	return true
+}
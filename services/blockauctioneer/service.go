@@ -61,3 +61,9 @@ type BlockAuctioneer interface {
 		error,
 	)
 }
+
+// HealthReporter is the interface for block auctioneers that can report their health.
+type HealthReporter interface {
+	// Healthy returns true if the service is able to handle requests.
+	Healthy(ctx context.Context) bool
+}
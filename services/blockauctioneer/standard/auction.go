@@ -0,0 +1,172 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/attestantio/go-block-relay/loggers"
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+	builderclient "github.com/attestantio/go-builder-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AuctionBlock obtains the best available use of the block space.
+// Bids are obtained and verified by the upstream auctioneer, then scored according to
+// the configuration of the provider that supplied them.
+func (s *Service) AuctionBlock(ctx context.Context,
+	slot phase0.Slot,
+	parentHash phase0.Hash32,
+	pubkey phase0.BLSPubKey,
+) (
+	*blockauctioneer.Results,
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.go-block-relay.services.blockauctioneer.standard").Start(ctx, "AuctionBlock", trace.WithAttributes(
+		attribute.Int64("slot", int64(slot)),
+		attribute.String("pubkey", pubkey.String()),
+	))
+	defer span.End()
+
+	upstreamRes, err := s.upstream.AuctionBlock(ctx, slot, parentHash, pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.selectWinner(ctx, slot, upstreamRes)
+}
+
+// selectWinner scores the upstream participations and selects the winning bid.
+// Priority bids take precedence over standard bids, and excluded bids never win.
+// Ties are retained by the provider listed first.
+// Missing upstream results, and participations without a bid or score, are treated as
+// no participation.
+func (s *Service) selectWinner(ctx context.Context,
+	slot phase0.Slot,
+	upstreamRes *blockauctioneer.Results,
+) (
+	*blockauctioneer.Results,
+	error,
+) {
+	log := loggers.WithRequestID(ctx, s.log)
+
+	res := &blockauctioneer.Results{
+		Participation: make(map[string]*blockauctioneer.Participation),
+		Providers:     make([]builderclient.BuilderBidProvider, 0),
+	}
+	if upstreamRes == nil {
+		log.Debug().Uint64("slot", uint64(slot)).Msg("No auction results received")
+
+		return res, nil
+	}
+	res.AllProviders = upstreamRes.AllProviders
+
+	var winner builderclient.BuilderBidProvider
+	for _, provider := range upstreamRes.AllProviders {
+		upstreamParticipation, exists := upstreamRes.Participation[provider.Name()]
+		if !exists || upstreamParticipation == nil || upstreamParticipation.Bid == nil || upstreamParticipation.Score == nil {
+			continue
+		}
+
+		participation := s.score(provider.Name(), upstreamParticipation)
+		res.Participation[provider.Name()] = participation
+
+		if participation.Category == CategoryExcluded {
+			continue
+		}
+
+		if winner == nil || beats(participation, res.Participation[winner.Name()]) {
+			winner = provider
+		}
+	}
+
+	if winner == nil {
+		log.Debug().Uint64("slot", uint64(slot)).Msg("No eligible bids received")
+
+		return res, nil
+	}
+
+	res.WinningParticipation = res.Participation[winner.Name()]
+
+	winningBlockHash, err := res.WinningParticipation.Bid.BlockHash()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain winning block hash")
+	}
+
+	for _, provider := range upstreamRes.AllProviders {
+		participation, exists := res.Participation[provider.Name()]
+		if !exists || participation.Category == CategoryExcluded {
+			continue
+		}
+
+		blockHash, err := participation.Bid.BlockHash()
+		if err != nil {
+			continue
+		}
+
+		if blockHash == winningBlockHash {
+			res.Providers = append(res.Providers, provider)
+		}
+	}
+
+	log.Trace().
+		Uint64("slot", uint64(slot)).
+		Str("provider", winner.Name()).
+		Str("category", res.WinningParticipation.Category).
+		Stringer("score", res.WinningParticipation.Score).
+		Msg("Selected winning bid")
+
+	return res, nil
+}
+
+// beats returns true if the candidate participation beats the current participation.
+// Ties are retained by the current participation.
+func beats(candidate *blockauctioneer.Participation, current *blockauctioneer.Participation) bool {
+	candidatePriority := candidate.Category == CategoryPriority
+	currentPriority := current.Category == CategoryPriority
+	if candidatePriority != currentPriority {
+		return candidatePriority
+	}
+
+	return candidate.Score.Cmp(current.Score) > 0
+}
+
+// score provides the participation of a provider's verified bid, adjusted according to
+// the provider's configuration.
+func (s *Service) score(name string,
+	upstreamParticipation *blockauctioneer.Participation,
+) *blockauctioneer.Participation {
+	participation := &blockauctioneer.Participation{
+		Category: CategoryStandard,
+		Score:    new(big.Int).Set(upstreamParticipation.Score),
+		Bid:      upstreamParticipation.Bid,
+	}
+
+	if config, exists := s.providerConfigs[name]; exists {
+		if config.Category != "" {
+			participation.Category = config.Category
+		}
+
+		if config.Offset != nil {
+			participation.Score.Add(participation.Score, config.Offset)
+		}
+	}
+
+	return participation
+}
@@ -0,0 +1,301 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+	mockblockauctioneer "github.com/attestantio/go-block-relay/services/blockauctioneer/mock"
+	"github.com/attestantio/go-block-relay/services/blockauctioneer/standard"
+	"github.com/attestantio/go-block-relay/services/builderbidprovider/multi"
+	"github.com/attestantio/go-block-relay/testing/builder"
+	builderclient "github.com/attestantio/go-builder-client"
+	"github.com/attestantio/go-builder-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuctionBlock(t *testing.T) {
+	ctx := context.Background()
+
+	parentHash := phase0.Hash32{0x01}
+
+	low := builder.NewProvider(t, "low", 0x01, 1000)
+	mid := builder.NewProvider(t, "mid", 0x02, 2000)
+	high := builder.NewProvider(t, "high", 0x03, 3000)
+	highDuplicate := builder.NewProvider(t, "high duplicate", 0x04, 3000)
+	highDuplicate.BlockHash = high.BlockHash
+	erroring := builder.NewProvider(t, "erroring", 0x05, 5000)
+	erroring.Err = errors.New("error")
+	midTwin := builder.NewProvider(t, "mid twin", 0x06, 2000)
+
+	tests := []struct {
+		name          string
+		providers     []builderclient.BuilderBidProvider
+		configs       map[string]*standard.ProviderConfig
+		participants  map[string]string
+		winner        string
+		score         int64
+		winProviders  []string
+		noWinningBids bool
+	}{
+		{
+			name:          "NoBids",
+			providers:     []builderclient.BuilderBidProvider{erroring},
+			participants:  map[string]string{},
+			noWinningBids: true,
+		},
+		{
+			name:      "HighestScore",
+			providers: []builderclient.BuilderBidProvider{low, mid, high, erroring},
+			participants: map[string]string{
+				"low":  standard.CategoryStandard,
+				"mid":  standard.CategoryStandard,
+				"high": standard.CategoryStandard,
+			},
+			winner:       "high",
+			score:        3000,
+			winProviders: []string{"high"},
+		},
+		{
+			name:      "Offset",
+			providers: []builderclient.BuilderBidProvider{low, mid, high},
+			configs: map[string]*standard.ProviderConfig{
+				"low":  {Offset: big.NewInt(2500)},
+				"high": {Offset: big.NewInt(-1500)},
+			},
+			participants: map[string]string{
+				"low":  standard.CategoryStandard,
+				"mid":  standard.CategoryStandard,
+				"high": standard.CategoryStandard,
+			},
+			winner:       "low",
+			score:        3500,
+			winProviders: []string{"low"},
+		},
+		{
+			name:      "Priority",
+			providers: []builderclient.BuilderBidProvider{low, mid, high},
+			configs: map[string]*standard.ProviderConfig{
+				"low": {Category: standard.CategoryPriority},
+			},
+			participants: map[string]string{
+				"low":  standard.CategoryPriority,
+				"mid":  standard.CategoryStandard,
+				"high": standard.CategoryStandard,
+			},
+			winner:       "low",
+			score:        1000,
+			winProviders: []string{"low"},
+		},
+		{
+			name:      "PriorityHighest",
+			providers: []builderclient.BuilderBidProvider{low, mid, high},
+			configs: map[string]*standard.ProviderConfig{
+				"low": {Category: standard.CategoryPriority},
+				"mid": {Category: standard.CategoryPriority},
+			},
+			participants: map[string]string{
+				"low":  standard.CategoryPriority,
+				"mid":  standard.CategoryPriority,
+				"high": standard.CategoryStandard,
+			},
+			winner:       "mid",
+			score:        2000,
+			winProviders: []string{"mid"},
+		},
+		{
+			name:      "Excluded",
+			providers: []builderclient.BuilderBidProvider{low, mid, high},
+			configs: map[string]*standard.ProviderConfig{
+				"high": {Category: standard.CategoryExcluded},
+			},
+			participants: map[string]string{
+				"low":  standard.CategoryStandard,
+				"mid":  standard.CategoryStandard,
+				"high": standard.CategoryExcluded,
+			},
+			winner:       "mid",
+			score:        2000,
+			winProviders: []string{"mid"},
+		},
+		{
+			name:      "AllExcluded",
+			providers: []builderclient.BuilderBidProvider{high},
+			configs: map[string]*standard.ProviderConfig{
+				"high": {Category: standard.CategoryExcluded},
+			},
+			participants: map[string]string{
+				"high": standard.CategoryExcluded,
+			},
+			noWinningBids: true,
+		},
+		{
+			name:      "Tie",
+			providers: []builderclient.BuilderBidProvider{low, midTwin, mid},
+			participants: map[string]string{
+				"low":      standard.CategoryStandard,
+				"mid twin": standard.CategoryStandard,
+				"mid":      standard.CategoryStandard,
+			},
+			winner:       "mid twin",
+			score:        2000,
+			winProviders: []string{"mid twin"},
+		},
+		{
+			name:      "DuplicateBlock",
+			providers: []builderclient.BuilderBidProvider{low, high, highDuplicate},
+			configs: map[string]*standard.ProviderConfig{
+				"high duplicate": {Category: standard.CategoryExcluded},
+			},
+			participants: map[string]string{
+				"low":            standard.CategoryStandard,
+				"high":           standard.CategoryStandard,
+				"high duplicate": standard.CategoryExcluded,
+			},
+			winner:       "high",
+			score:        3000,
+			winProviders: []string{"high"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			upstream, err := multi.New(ctx,
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithTimeout(200*time.Millisecond),
+				multi.WithBuilderBidProviders(test.providers),
+			)
+			require.NoError(t, err)
+
+			params := []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithUpstream(upstream),
+			}
+			if test.configs != nil {
+				params = append(params, standard.WithProviderConfigs(test.configs))
+			}
+			s, err := standard.New(ctx, params...)
+			require.NoError(t, err)
+
+			res, err := s.AuctionBlock(ctx, 1, parentHash, phase0.BLSPubKey{})
+			require.NoError(t, err)
+
+			require.Len(t, res.AllProviders, len(test.providers))
+			require.Len(t, res.Participation, len(test.participants))
+			for name, category := range test.participants {
+				require.Contains(t, res.Participation, name)
+				require.Equal(t, category, res.Participation[name].Category)
+			}
+
+			if test.noWinningBids {
+				require.Nil(t, res.WinningParticipation)
+				require.Empty(t, res.Providers)
+
+				return
+			}
+
+			require.Equal(t, res.Participation[test.winner], res.WinningParticipation)
+			require.Equal(t, test.score, res.WinningParticipation.Score.Int64())
+
+			winProviders := make([]string, 0, len(res.Providers))
+			for _, provider := range res.Providers {
+				winProviders = append(winProviders, provider.Name())
+			}
+			require.ElementsMatch(t, test.winProviders, winProviders)
+		})
+	}
+}
+
+func TestAuctionBlockUpstreamErroring(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithUpstream(mockblockauctioneer.NewErroring()),
+	)
+	require.NoError(t, err)
+
+	_, err = s.AuctionBlock(ctx, 1, phase0.Hash32{0x01}, phase0.BLSPubKey{})
+	require.Error(t, err)
+}
+
+// fixedUpstream is an upstream auctioneer that returns fixed results.
+type fixedUpstream struct {
+	res *blockauctioneer.Results
+}
+
+func (f *fixedUpstream) AuctionBlock(_ context.Context,
+	_ phase0.Slot,
+	_ phase0.Hash32,
+	_ phase0.BLSPubKey,
+) (
+	*blockauctioneer.Results,
+	error,
+) {
+	return f.res, nil
+}
+
+func TestAuctionBlockNoParticipation(t *testing.T) {
+	ctx := context.Background()
+
+	parentHash := phase0.Hash32{0x01}
+
+	provider := builder.NewProvider(t, "provider", 0x01, 1000)
+	bid, err := provider.BuilderBid(ctx, &api.BuilderBidOpts{ParentHash: parentHash})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		res  *blockauctioneer.Results
+	}{
+		{
+			name: "NilResults",
+		},
+		{
+			name: "NilScore",
+			res: &blockauctioneer.Results{
+				Participation: map[string]*blockauctioneer.Participation{
+					"provider": {
+						Bid: bid.Data,
+					},
+				},
+				AllProviders: []builderclient.BuilderBidProvider{provider},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := standard.New(ctx,
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithUpstream(&fixedUpstream{res: test.res}),
+			)
+			require.NoError(t, err)
+
+			res, err := s.AuctionBlock(ctx, 1, parentHash, phase0.BLSPubKey{})
+			require.NoError(t, err)
+			require.NotNil(t, res)
+			require.Empty(t, res.Participation)
+			require.Nil(t, res.WinningParticipation)
+			require.Empty(t, res.Providers)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+
+	"github.com/attestantio/go-block-relay/services/builderbidprovider"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BidBuilder provides the public key of the builder of the bid with the given block hash,
+// from the upstream auctioneer if it supplies builders.
+// It returns nil if the builder is not known.
+func (s *Service) BidBuilder(ctx context.Context,
+	slot phase0.Slot,
+	blockHash phase0.Hash32,
+) (
+	*phase0.BLSPubKey,
+	error,
+) {
+	builderProvider, isBuilderProvider := s.upstream.(builderbidprovider.BidBuilderProvider)
+	if !isBuilderProvider {
+		return nil, nil
+	}
+
+	return builderProvider.BidBuilder(ctx, slot, blockHash)
+}
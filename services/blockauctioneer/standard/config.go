@@ -0,0 +1,38 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"math/big"
+)
+
+const (
+	// CategoryStandard is the category for providers with no specific configuration.
+	CategoryStandard = "Standard"
+	// CategoryPriority is the category for providers whose bids take precedence over
+	// those of standard providers, regardless of score.
+	CategoryPriority = "Priority"
+	// CategoryExcluded is the category for providers whose bids are recorded but never win.
+	CategoryExcluded = "Excluded"
+)
+
+// ProviderConfig is the configuration for an upstream provider.
+type ProviderConfig struct {
+	// Category is the category of the provider.
+	// This can be CategoryPriority or CategoryExcluded; if empty, CategoryStandard is used.
+	Category string
+	// Offset is added to the value of the provider's bids to obtain their score.
+	// It can be negative, to penalise a provider.
+	Offset *big.Int
+}
@@ -0,0 +1,31 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+)
+
+// Healthy returns true if the service is able to handle requests.
+// The service holds no state of its own, so is as healthy as its upstream auctioneer.
+func (s *Service) Healthy(ctx context.Context) bool {
+	reporter, isReporter := s.upstream.(blockauctioneer.HealthReporter)
+	if !isReporter {
+		return true
+	}
+
+	return reporter.Healthy(ctx)
+}
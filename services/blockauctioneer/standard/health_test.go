@@ -0,0 +1,92 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+	mockblockauctioneer "github.com/attestantio/go-block-relay/services/blockauctioneer/mock"
+	"github.com/attestantio/go-block-relay/services/blockauctioneer/standard"
+	"github.com/attestantio/go-block-relay/services/builderbidprovider/multi"
+	"github.com/attestantio/go-block-relay/testing/builder"
+	builderclient "github.com/attestantio/go-builder-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthy(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		upstream blockauctioneer.BlockAuctioneer
+		healthy  bool
+	}{
+		{
+			name:     "UpstreamHealthy",
+			upstream: mockblockauctioneer.New(),
+			healthy:  true,
+		},
+		{
+			name:     "UpstreamUnhealthy",
+			upstream: mockblockauctioneer.NewErroring(),
+			healthy:  false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := standard.New(ctx,
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithUpstream(test.upstream),
+			)
+			require.NoError(t, err)
+			require.Equal(t, test.healthy, s.Healthy(ctx))
+		})
+	}
+}
+
+func TestHealthyUpstreamRecovery(t *testing.T) {
+	ctx := context.Background()
+
+	provider := builder.NewProvider(t, "provider", 0x01, 1000)
+	provider.Err = errors.New("error")
+
+	upstream, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithTimeout(200*time.Millisecond),
+		multi.WithHealthRecovery(100*time.Millisecond),
+		multi.WithBuilderBidProviders([]builderclient.BuilderBidProvider{provider}),
+	)
+	require.NoError(t, err)
+
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithUpstream(upstream),
+	)
+	require.NoError(t, err)
+
+	_, err = s.AuctionBlock(ctx, 1, phase0.Hash32{0x01}, phase0.BLSPubKey{})
+	require.NoError(t, err)
+	require.False(t, s.Healthy(ctx))
+
+	// The upstream recovers, and with it the auctioneer.
+	time.Sleep(150 * time.Millisecond)
+	require.True(t, s.Healthy(ctx))
+}
@@ -0,0 +1,93 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel        zerolog.Level
+	upstream        blockauctioneer.BlockAuctioneer
+	providerConfigs map[string]*ProviderConfig
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithUpstream sets the auctioneer that obtains and verifies bids from upstream providers,
+// for example a multi builder bid provider.  Its bids are rescored by this auctioneer.
+func WithUpstream(upstream blockauctioneer.BlockAuctioneer) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.upstream = upstream
+	})
+}
+
+// WithProviderConfigs sets the configuration for upstream providers, keyed by provider name.
+func WithProviderConfigs(configs map[string]*ProviderConfig) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.providerConfigs = configs
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:        zerolog.GlobalLevel(),
+		providerConfigs: make(map[string]*ProviderConfig),
+	}
+
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.upstream == nil {
+		return nil, errors.New("no upstream specified")
+	}
+
+	for name, config := range parameters.providerConfigs {
+		if config == nil {
+			return nil, fmt.Errorf("nil configuration for provider %s", name)
+		}
+
+		switch config.Category {
+		case "", CategoryPriority, CategoryExcluded:
+		default:
+			return nil, fmt.Errorf("unknown category %q for provider %s", config.Category, name)
+		}
+	}
+
+	return &parameters, nil
+}
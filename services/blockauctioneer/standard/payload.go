@@ -0,0 +1,40 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+
+	"github.com/attestantio/go-block-relay/services/builderbidprovider"
+	builderapi "github.com/attestantio/go-builder-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ExecutionPayload provides the execution payload and blobs bundle for the bid with the given block hash,
+// from the upstream auctioneer if it supplies payloads.
+// It returns nil if the payload is not known.
+func (s *Service) ExecutionPayload(ctx context.Context,
+	slot phase0.Slot,
+	blockHash phase0.Hash32,
+) (
+	*builderapi.VersionedSubmitBlindedBlockResponse,
+	error,
+) {
+	payloadProvider, isPayloadProvider := s.upstream.(builderbidprovider.ExecutionPayloadProvider)
+	if !isPayloadProvider {
+		return nil, nil
+	}
+
+	return payloadProvider.ExecutionPayload(ctx, slot, blockHash)
+}
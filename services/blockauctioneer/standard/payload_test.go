@@ -0,0 +1,81 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+	mockblockauctioneer "github.com/attestantio/go-block-relay/services/blockauctioneer/mock"
+	"github.com/attestantio/go-block-relay/services/blockauctioneer/standard"
+	"github.com/attestantio/go-block-relay/services/builderbidprovider/multi"
+	"github.com/attestantio/go-block-relay/testing/builder"
+	builderclient "github.com/attestantio/go-builder-client"
+	builderapi "github.com/attestantio/go-builder-client/api"
+	consensusspec "github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionPayload(t *testing.T) {
+	ctx := context.Background()
+
+	payload := &builderapi.VersionedSubmitBlindedBlockResponse{
+		Version: consensusspec.DataVersionElectra,
+	}
+
+	provider := &builder.PayloadProvider{
+		Provider: builder.NewProvider(t, "provider", 0x01, 1000),
+		Payloads: map[phase0.Hash32]*builderapi.VersionedSubmitBlindedBlockResponse{
+			{0x01}: payload,
+		},
+	}
+	upstream, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithBuilderBidProviders([]builderclient.BuilderBidProvider{provider}),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		upstream blockauctioneer.BlockAuctioneer
+		payload  *builderapi.VersionedSubmitBlindedBlockResponse
+	}{
+		{
+			name:     "Upstream",
+			upstream: upstream,
+			payload:  payload,
+		},
+		{
+			name:     "UpstreamNoPayloads",
+			upstream: mockblockauctioneer.New(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := standard.New(ctx,
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithUpstream(test.upstream),
+			)
+			require.NoError(t, err)
+
+			res, err := s.ExecutionPayload(ctx, 1, phase0.Hash32{0x01})
+			require.NoError(t, err)
+			require.Equal(t, test.payload, res)
+		})
+	}
+}
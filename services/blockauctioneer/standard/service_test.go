@@ -0,0 +1,86 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	mockblockauctioneer "github.com/attestantio/go-block-relay/services/blockauctioneer/mock"
+	"github.com/attestantio/go-block-relay/services/blockauctioneer/standard"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []standard.Parameter
+		err    string
+	}{
+		{
+			name: "UpstreamMissing",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no upstream specified",
+		},
+		{
+			name: "ProviderConfigNil",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithUpstream(mockblockauctioneer.New()),
+				standard.WithProviderConfigs(map[string]*standard.ProviderConfig{
+					"upstream": nil,
+				}),
+			},
+			err: "problem with parameters: nil configuration for provider upstream",
+		},
+		{
+			name: "ProviderConfigCategoryUnknown",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithUpstream(mockblockauctioneer.New()),
+				standard.WithProviderConfigs(map[string]*standard.ProviderConfig{
+					"upstream": {Category: "Unknown"},
+				}),
+			},
+			err: "problem with parameters: unknown category \"Unknown\" for provider upstream",
+		},
+		{
+			name: "Good",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithUpstream(mockblockauctioneer.New()),
+				standard.WithProviderConfigs(map[string]*standard.ProviderConfig{
+					"upstream": {Category: standard.CategoryPriority, Offset: big.NewInt(-1)},
+				}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := standard.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
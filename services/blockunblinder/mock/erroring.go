@@ -1,4 +1,4 @@
-// Copyright © 2024 Attestant Limited.
+// Copyright © 2024, 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -37,3 +37,18 @@ func (s *ErroringService) UnblindBlock(_ context.Context,
 ) {
 	return nil, errors.New("error")
 }
+
+// UnblindAndPublishBlock unblinds the given block and publishes it.
+func (s *ErroringService) UnblindAndPublishBlock(_ context.Context,
+	_ *api.VersionedSignedBlindedBeaconBlock,
+) (
+	*api.VersionedSignedProposal,
+	error,
+) {
+	return nil, errors.New("error")
+}
+
+// Healthy returns true if the service is able to handle requests.
+func (s *ErroringService) Healthy(_ context.Context) bool {
+	return false
+}
@@ -1,4 +1,4 @@
-// Copyright © 2024 Attestant Limited.
+// Copyright © 2024, 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -36,3 +36,18 @@ func (s *Service) UnblindBlock(_ context.Context,
 ) {
 	return &api.VersionedSignedProposal{}, nil
 }
+
+// UnblindAndPublishBlock unblinds the given block and publishes it.
+func (s *Service) UnblindAndPublishBlock(_ context.Context,
+	_ *api.VersionedSignedBlindedBeaconBlock,
+) (
+	*api.VersionedSignedProposal,
+	error,
+) {
+	return &api.VersionedSignedProposal{}, nil
+}
+
+// Healthy returns true if the service is able to handle requests.
+func (s *Service) Healthy(_ context.Context) bool {
+	return true
+}
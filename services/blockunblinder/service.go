@@ -1,4 +1,4 @@
-// Copyright © 2022, 2024 Attestant Limited.
+// Copyright © 2022 - 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -16,7 +16,9 @@ package blockunblinder
 import (
 	"context"
 
+	builderapi "github.com/attestantio/go-builder-client/api"
 	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
 // Service defines the block unblinder service.
@@ -29,3 +31,32 @@ type Service interface {
 		error,
 	)
 }
+
+// BlockPublisher is the interface for unblinding blocks and publishing them to the network.
+type BlockPublisher interface {
+	// UnblindAndPublishBlock unblinds the given block and publishes it,
+	// returning the published proposal.
+	UnblindAndPublishBlock(ctx context.Context,
+		block *api.VersionedSignedBlindedBeaconBlock,
+	) (
+		*api.VersionedSignedProposal,
+		error,
+	)
+}
+
+// PayloadRecorder is the interface for unblinders that unblind blocks using
+// execution payloads recorded when the corresponding bids were served.
+type PayloadRecorder interface {
+	// RecordPayload records the execution payload and blobs bundle behind a bid served to a proposer.
+	RecordPayload(ctx context.Context,
+		slot phase0.Slot,
+		pubkey phase0.BLSPubKey,
+		payload *builderapi.VersionedSubmitBlindedBlockResponse,
+	) error
+}
+
+// HealthReporter is the interface for block unblinders that can report their health.
+type HealthReporter interface {
+	// Healthy returns true if the service is able to handle requests.
+	Healthy(ctx context.Context) bool
+}
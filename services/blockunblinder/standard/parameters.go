@@ -0,0 +1,109 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"errors"
+
+	"github.com/attestantio/go-block-relay/services/proposerdutiesprovider"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel               zerolog.Level
+	retentionSlots         uint64
+	proposerDutiesProvider proposerdutiesprovider.Service
+	forkVersions           map[spec.DataVersion]phase0.Version
+	genesisValidatorsRoot  phase0.Root
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithRetentionSlots sets the number of slots for which recorded payloads are retained.
+func WithRetentionSlots(slots uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retentionSlots = slots
+	})
+}
+
+// WithProposerDutiesProvider sets the provider of proposer duties, used to confirm the proposer of a block.
+func WithProposerDutiesProvider(provider proposerdutiesprovider.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.proposerDutiesProvider = provider
+	})
+}
+
+// WithForkVersions sets the fork version of the chain for each block version, used to verify block signatures.
+func WithForkVersions(versions map[spec.DataVersion]phase0.Version) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.forkVersions = versions
+	})
+}
+
+// WithGenesisValidatorsRoot sets the genesis validators root of the chain, used to verify block signatures.
+func WithGenesisValidatorsRoot(root phase0.Root) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisValidatorsRoot = root
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:       zerolog.GlobalLevel(),
+		retentionSlots: 64,
+	}
+
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.retentionSlots == 0 {
+		return nil, errors.New("retention slots must be greater than 0")
+	}
+
+	if parameters.proposerDutiesProvider == nil {
+		return nil, errors.New("no proposer duties provider specified")
+	}
+
+	if len(parameters.forkVersions) == 0 {
+		return nil, errors.New("no fork versions specified")
+	}
+
+	if parameters.genesisValidatorsRoot.IsZero() {
+		return nil, errors.New("no genesis validators root specified")
+	}
+
+	return &parameters, nil
+}
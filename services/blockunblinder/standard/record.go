@@ -0,0 +1,105 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/attestantio/go-block-relay/loggers"
+	builderapi "github.com/attestantio/go-builder-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// RecordPayload records the execution payload and blobs bundle behind a bid served to a proposer.
+func (s *Service) RecordPayload(ctx context.Context,
+	slot phase0.Slot,
+	pubkey phase0.BLSPubKey,
+	payload *builderapi.VersionedSubmitBlindedBlockResponse,
+) error {
+	log := loggers.WithRequestID(ctx, s.log)
+
+	if payload == nil {
+		return errors.New("no payload supplied")
+	}
+
+	if !supportedVersion(payload.Version) {
+		return fmt.Errorf("unsupported payload version %v", payload.Version)
+	}
+
+	if payload.IsEmpty() {
+		return errors.New("payload is empty")
+	}
+
+	complete := false
+	switch payload.Version {
+	case spec.DataVersionDeneb:
+		complete = payload.Deneb.ExecutionPayload != nil && payload.Deneb.BlobsBundle != nil
+	case spec.DataVersionElectra:
+		complete = payload.Electra.ExecutionPayload != nil && payload.Electra.BlobsBundle != nil
+	case spec.DataVersionFulu:
+		complete = payload.Fulu.ExecutionPayload != nil && payload.Fulu.BlobsBundle != nil
+	default:
+		return fmt.Errorf("unsupported payload version %v", payload.Version)
+	}
+
+	if !complete {
+		return errors.New("payload incomplete")
+	}
+
+	blockHash, err := payload.BlockHash()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain block hash")
+	}
+
+	s.payloadsMu.Lock()
+	s.payloads[blockHash] = &payloadRecord{
+		slot:    slot,
+		pubkey:  pubkey,
+		payload: payload,
+	}
+	if slot > s.highestSlot {
+		s.highestSlot = slot
+		s.prune(ctx)
+	}
+	s.payloadsMu.Unlock()
+
+	log.Trace().Uint64("slot", uint64(slot)).Stringer("block_hash", blockHash).Msg("Recorded payload")
+
+	return nil
+}
+
+// prune removes payloads that are outside of the retention period.
+// This must be called with the payloads lock held.
+func (s *Service) prune(ctx context.Context) {
+	if uint64(s.highestSlot) < s.retentionSlots {
+		return
+	}
+
+	minSlot := s.highestSlot - phase0.Slot(s.retentionSlots)
+	pruned := 0
+	for blockHash, record := range s.payloads {
+		if record.slot < minSlot {
+			delete(s.payloads, blockHash)
+			pruned++
+		}
+	}
+
+	if pruned > 0 {
+		log := loggers.WithRequestID(ctx, s.log)
+		log.Trace().Uint64("min_slot", uint64(minSlot)).Int("pruned", pruned).Msg("Pruned payloads")
+	}
+}
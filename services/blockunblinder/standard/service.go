@@ -0,0 +1,95 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"sync"
+
+	"github.com/attestantio/go-block-relay/services/proposerdutiesprovider"
+	"github.com/attestantio/go-block-relay/signing"
+	builderapi "github.com/attestantio/go-builder-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// payloadRecord is a recorded execution payload, along with the proposer to
+// whom the corresponding bid was served.
+type payloadRecord struct {
+	slot    phase0.Slot
+	pubkey  phase0.BLSPubKey
+	payload *builderapi.VersionedSubmitBlindedBlockResponse
+}
+
+// Service is a block unblinder that unblinds blocks using recorded payloads.
+type Service struct {
+	log                    zerolog.Logger
+	retentionSlots         uint64
+	proposerDutiesProvider proposerdutiesprovider.Service
+	proposerDomains        map[spec.DataVersion]phase0.Domain
+
+	payloadsMu  sync.RWMutex
+	payloads    map[phase0.Hash32]*payloadRecord
+	highestSlot phase0.Slot
+}
+
+// New creates a new block unblinder.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "blockunblinder").Str("impl", "standard").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	proposerDomains := make(map[spec.DataVersion]phase0.Domain, len(parameters.forkVersions))
+	for version, forkVersion := range parameters.forkVersions {
+		proposerDomains[version], err = signing.ComputeDomain(signing.DomainBeaconProposer,
+			forkVersion,
+			parameters.genesisValidatorsRoot,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to compute proposer domain")
+		}
+	}
+
+	s := &Service{
+		log:                    log,
+		retentionSlots:         parameters.retentionSlots,
+		proposerDutiesProvider: parameters.proposerDutiesProvider,
+		proposerDomains:        proposerDomains,
+		payloads:               make(map[phase0.Hash32]*payloadRecord),
+	}
+
+	return s, nil
+}
+
+// supportedVersion returns true if blocks of the given version can be unblinded.
+// Versions before Deneb are rejected when recording and when unblinding alike,
+// as their payloads carry no blobs bundle for the unblinded response.
+func supportedVersion(version spec.DataVersion) bool {
+	switch version {
+	case spec.DataVersionDeneb, spec.DataVersionElectra, spec.DataVersionFulu:
+		return true
+	default:
+		return false
+	}
+}
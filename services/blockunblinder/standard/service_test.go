@@ -0,0 +1,109 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-block-relay/services/blockunblinder/standard"
+	mockproposerdutiesprovider "github.com/attestantio/go-block-relay/services/proposerdutiesprovider/mock"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	dutiesProvider := mockproposerdutiesprovider.New()
+	forkVersions := map[spec.DataVersion]phase0.Version{
+		spec.DataVersionDeneb: {0x04},
+	}
+	genesisValidatorsRoot := phase0.Root{0x01}
+
+	tests := []struct {
+		name   string
+		params []standard.Parameter
+		err    string
+	}{
+		{
+			name: "RetentionSlotsZero",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithRetentionSlots(0),
+				standard.WithProposerDutiesProvider(dutiesProvider),
+				standard.WithForkVersions(forkVersions),
+				standard.WithGenesisValidatorsRoot(genesisValidatorsRoot),
+			},
+			err: "problem with parameters: retention slots must be greater than 0",
+		},
+		{
+			name: "ProposerDutiesProviderMissing",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithForkVersions(forkVersions),
+				standard.WithGenesisValidatorsRoot(genesisValidatorsRoot),
+			},
+			err: "problem with parameters: no proposer duties provider specified",
+		},
+		{
+			name: "ForkVersionsMissing",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithProposerDutiesProvider(dutiesProvider),
+				standard.WithGenesisValidatorsRoot(genesisValidatorsRoot),
+			},
+			err: "problem with parameters: no fork versions specified",
+		},
+		{
+			name: "GenesisValidatorsRootMissing",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithProposerDutiesProvider(dutiesProvider),
+				standard.WithForkVersions(forkVersions),
+			},
+			err: "problem with parameters: no genesis validators root specified",
+		},
+		{
+			name: "Good",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithRetentionSlots(32),
+				standard.WithProposerDutiesProvider(dutiesProvider),
+				standard.WithForkVersions(forkVersions),
+				standard.WithGenesisValidatorsRoot(genesisValidatorsRoot),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := standard.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHealthy(t *testing.T) {
+	ctx := context.Background()
+
+	s := testService(ctx, t, mockproposerdutiesprovider.New())
+	require.True(t, s.Healthy(ctx))
+}
@@ -0,0 +1,392 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"fmt"
+
+	relay "github.com/attestantio/go-block-relay"
+	"github.com/attestantio/go-block-relay/loggers"
+	"github.com/attestantio/go-block-relay/signing"
+	builderapi "github.com/attestantio/go-builder-client/api"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	apiv1fulu "github.com/attestantio/go-eth2-client/api/v1/fulu"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// UnblindBlock unblinds the given block using a previously recorded payload.
+func (s *Service) UnblindBlock(ctx context.Context,
+	block *api.VersionedSignedBlindedBeaconBlock,
+) (
+	*api.VersionedSignedProposal,
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.go-block-relay.services.blockunblinder.standard").Start(ctx, "UnblindBlock")
+	defer span.End()
+
+	proposal, err := s.unblindBlock(ctx, block)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return proposal, err
+}
+
+func (s *Service) unblindBlock(ctx context.Context,
+	block *api.VersionedSignedBlindedBeaconBlock,
+) (
+	*api.VersionedSignedProposal,
+	error,
+) {
+	log := loggers.WithRequestID(ctx, s.log)
+
+	if block == nil {
+		return nil, errors.Wrap(relay.ErrInvalidOptions, "no block supplied")
+	}
+
+	if !supportedVersion(block.Version) {
+		return nil, errors.Wrap(relay.ErrInvalidOptions, fmt.Sprintf("unsupported block version %v", block.Version))
+	}
+
+	slot, err := block.Slot()
+	if err != nil {
+		return nil, errors.Wrap(relay.ErrInvalidOptions, err.Error())
+	}
+
+	blockHash, err := block.ExecutionBlockHash()
+	if err != nil {
+		return nil, errors.Wrap(relay.ErrInvalidOptions, err.Error())
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(
+		attribute.Int64("slot", int64(slot)),
+		attribute.String("block_hash", blockHash.String()),
+	)
+
+	s.payloadsMu.RLock()
+	record, exists := s.payloads[blockHash]
+	s.payloadsMu.RUnlock()
+
+	if !exists {
+		return nil, errors.Wrap(relay.ErrInvalidOptions, fmt.Sprintf("no payload for block hash %#x", blockHash))
+	}
+
+	if record.slot != slot {
+		return nil, errors.Wrap(relay.ErrInvalidOptions,
+			fmt.Sprintf("block slot %d does not match payload slot %d", slot, record.slot),
+		)
+	}
+
+	if record.payload.Version != block.Version {
+		return nil, errors.Wrap(relay.ErrInvalidOptions,
+			fmt.Sprintf("block version %v does not match payload version %v", block.Version, record.payload.Version),
+		)
+	}
+
+	if err := s.checkProposer(ctx, block, slot, record.pubkey); err != nil {
+		return nil, err
+	}
+
+	var proposal *api.VersionedSignedProposal
+
+	switch block.Version {
+	case spec.DataVersionDeneb:
+		proposal, err = unblindDeneb(block, record.payload)
+	case spec.DataVersionElectra:
+		proposal, err = unblindElectra(block, record.payload)
+	case spec.DataVersionFulu:
+		proposal, err = unblindFulu(block, record.payload)
+	default:
+		return nil, errors.Wrap(relay.ErrInvalidOptions, fmt.Sprintf("unsupported block version %v", block.Version))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	log.Trace().Uint64("slot", uint64(slot)).Stringer("block_hash", blockHash).Msg("Unblinded block")
+
+	return proposal, nil
+}
+
+// checkProposer confirms that the block was proposed and signed by the proposer for
+// the slot, and that the proposer is the one to whom the bid was served.
+func (s *Service) checkProposer(ctx context.Context,
+	block *api.VersionedSignedBlindedBeaconBlock,
+	slot phase0.Slot,
+	pubkey phase0.BLSPubKey,
+) error {
+	proposerIndex, err := block.ProposerIndex()
+	if err != nil {
+		return errors.Wrap(relay.ErrInvalidOptions, err.Error())
+	}
+
+	duties, err := s.proposerDutiesProvider.ProposerDuties(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain proposer duties")
+	}
+
+	var duty *apiv1.ProposerDuty
+	for _, candidate := range duties {
+		if candidate != nil && candidate.Slot == slot {
+			duty = candidate
+
+			break
+		}
+	}
+	if duty == nil {
+		return errors.Wrap(relay.ErrInvalidOptions, fmt.Sprintf("no proposer duty for slot %d", slot))
+	}
+
+	if duty.ValidatorIndex != proposerIndex {
+		return errors.Wrap(relay.ErrInvalidOptions,
+			fmt.Sprintf("block proposer index %d does not match proposer index %d for slot", proposerIndex, duty.ValidatorIndex),
+		)
+	}
+
+	if duty.PubKey != pubkey {
+		return errors.Wrap(relay.ErrInvalidOptions,
+			fmt.Sprintf("proposer %#x for slot does not match bid recipient %#x", duty.PubKey, pubkey),
+		)
+	}
+
+	domain, exists := s.proposerDomains[block.Version]
+	if !exists {
+		return fmt.Errorf("no fork version for block version %v", block.Version)
+	}
+
+	root, err := block.Root()
+	if err != nil {
+		return errors.Wrap(relay.ErrInvalidOptions, "failed to calculate block root")
+	}
+
+	signingRoot, err := signing.ComputeSigningRoot(root, domain)
+	if err != nil {
+		return err
+	}
+
+	signature, err := block.Signature()
+	if err != nil {
+		return errors.Wrap(relay.ErrInvalidOptions, err.Error())
+	}
+
+	verified, err := signing.Verify(pubkey, signingRoot, signature)
+	if err != nil {
+		return errors.Wrap(relay.ErrInvalidOptions, err.Error())
+	}
+	if !verified {
+		return errors.Wrap(relay.ErrInvalidOptions, "block signature does not verify")
+	}
+
+	return nil
+}
+
+func unblindDeneb(block *api.VersionedSignedBlindedBeaconBlock,
+	payload *builderapi.VersionedSubmitBlindedBlockResponse,
+) (
+	*api.VersionedSignedProposal,
+	error,
+) {
+	if block.Deneb == nil || block.Deneb.Message == nil || block.Deneb.Message.Body == nil {
+		return nil, errors.Wrap(relay.ErrInvalidOptions, "block incomplete")
+	}
+
+	body := block.Deneb.Message.Body
+
+	err := checkPayload(body.ExecutionPayloadHeader,
+		body.BlobKZGCommitments,
+		payload.Deneb.ExecutionPayload,
+		payload.Deneb.BlobsBundle.Commitments,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.VersionedSignedProposal{
+		Version: spec.DataVersionDeneb,
+		Deneb: &apiv1deneb.SignedBlockContents{
+			SignedBlock: &deneb.SignedBeaconBlock{
+				Message: &deneb.BeaconBlock{
+					Slot:          block.Deneb.Message.Slot,
+					ProposerIndex: block.Deneb.Message.ProposerIndex,
+					ParentRoot:    block.Deneb.Message.ParentRoot,
+					StateRoot:     block.Deneb.Message.StateRoot,
+					Body: &deneb.BeaconBlockBody{
+						RANDAOReveal:          body.RANDAOReveal,
+						ETH1Data:              body.ETH1Data,
+						Graffiti:              body.Graffiti,
+						ProposerSlashings:     body.ProposerSlashings,
+						AttesterSlashings:     body.AttesterSlashings,
+						Attestations:          body.Attestations,
+						Deposits:              body.Deposits,
+						VoluntaryExits:        body.VoluntaryExits,
+						SyncAggregate:         body.SyncAggregate,
+						ExecutionPayload:      payload.Deneb.ExecutionPayload,
+						BLSToExecutionChanges: body.BLSToExecutionChanges,
+						BlobKZGCommitments:    body.BlobKZGCommitments,
+					},
+				},
+				Signature: block.Deneb.Signature,
+			},
+			KZGProofs: payload.Deneb.BlobsBundle.Proofs,
+			Blobs:     payload.Deneb.BlobsBundle.Blobs,
+		},
+	}, nil
+}
+
+func unblindElectra(block *api.VersionedSignedBlindedBeaconBlock,
+	payload *builderapi.VersionedSubmitBlindedBlockResponse,
+) (
+	*api.VersionedSignedProposal,
+	error,
+) {
+	signedBlock, err := unblindElectraBlock(block.Electra,
+		payload.Electra.ExecutionPayload,
+		payload.Electra.BlobsBundle.Commitments,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.VersionedSignedProposal{
+		Version: spec.DataVersionElectra,
+		Electra: &apiv1electra.SignedBlockContents{
+			SignedBlock: signedBlock,
+			KZGProofs:   payload.Electra.BlobsBundle.Proofs,
+			Blobs:       payload.Electra.BlobsBundle.Blobs,
+		},
+	}, nil
+}
+
+func unblindFulu(block *api.VersionedSignedBlindedBeaconBlock,
+	payload *builderapi.VersionedSubmitBlindedBlockResponse,
+) (
+	*api.VersionedSignedProposal,
+	error,
+) {
+	signedBlock, err := unblindElectraBlock(block.Fulu,
+		payload.Fulu.ExecutionPayload,
+		payload.Fulu.BlobsBundle.Commitments,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.VersionedSignedProposal{
+		Version: spec.DataVersionFulu,
+		Fulu: &apiv1fulu.SignedBlockContents{
+			SignedBlock: signedBlock,
+			KZGProofs:   payload.Fulu.BlobsBundle.Proofs,
+			Blobs:       payload.Fulu.BlobsBundle.Blobs,
+		},
+	}, nil
+}
+
+// unblindElectraBlock unblinds an Electra-format block, as used by both Electra and Fulu.
+func unblindElectraBlock(block *apiv1electra.SignedBlindedBeaconBlock,
+	executionPayload *deneb.ExecutionPayload,
+	commitments []deneb.KZGCommitment,
+) (
+	*electra.SignedBeaconBlock,
+	error,
+) {
+	if block == nil || block.Message == nil || block.Message.Body == nil {
+		return nil, errors.Wrap(relay.ErrInvalidOptions, "block incomplete")
+	}
+
+	body := block.Message.Body
+
+	err := checkPayload(body.ExecutionPayloadHeader, body.BlobKZGCommitments, executionPayload, commitments)
+	if err != nil {
+		return nil, err
+	}
+
+	return &electra.SignedBeaconBlock{
+		Message: &electra.BeaconBlock{
+			Slot:          block.Message.Slot,
+			ProposerIndex: block.Message.ProposerIndex,
+			ParentRoot:    block.Message.ParentRoot,
+			StateRoot:     block.Message.StateRoot,
+			Body: &electra.BeaconBlockBody{
+				RANDAOReveal:          body.RANDAOReveal,
+				ETH1Data:              body.ETH1Data,
+				Graffiti:              body.Graffiti,
+				ProposerSlashings:     body.ProposerSlashings,
+				AttesterSlashings:     body.AttesterSlashings,
+				Attestations:          body.Attestations,
+				Deposits:              body.Deposits,
+				VoluntaryExits:        body.VoluntaryExits,
+				SyncAggregate:         body.SyncAggregate,
+				ExecutionPayload:      executionPayload,
+				BLSToExecutionChanges: body.BLSToExecutionChanges,
+				BlobKZGCommitments:    body.BlobKZGCommitments,
+				ExecutionRequests:     body.ExecutionRequests,
+			},
+		},
+		Signature: block.Signature,
+	}, nil
+}
+
+// checkPayload confirms that the blinded block's header and commitments match the payload.
+// The header is a summary of the payload with the same hash tree root, so comparing roots
+// confirms every field including the transactions and withdrawals.
+func checkPayload(header *deneb.ExecutionPayloadHeader,
+	headerCommitments []deneb.KZGCommitment,
+	payload *deneb.ExecutionPayload,
+	payloadCommitments []deneb.KZGCommitment,
+) error {
+	if header == nil {
+		return errors.Wrap(relay.ErrInvalidOptions, "block has no execution payload header")
+	}
+
+	headerRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(relay.ErrInvalidOptions, "failed to calculate execution payload header root")
+	}
+
+	payloadRoot, err := payload.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate execution payload root")
+	}
+
+	if headerRoot != payloadRoot {
+		return errors.Wrap(relay.ErrInvalidOptions, "execution payload header does not match payload")
+	}
+
+	if len(headerCommitments) != len(payloadCommitments) {
+		return errors.Wrap(relay.ErrInvalidOptions,
+			fmt.Sprintf("block has %d blob commitments, payload has %d", len(headerCommitments), len(payloadCommitments)),
+		)
+	}
+
+	for i := range headerCommitments {
+		if headerCommitments[i] != payloadCommitments[i] {
+			return errors.Wrap(relay.ErrInvalidOptions, fmt.Sprintf("blob commitment %d does not match payload", i))
+		}
+	}
+
+	return nil
+}
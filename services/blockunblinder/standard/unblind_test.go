@@ -0,0 +1,591 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	relay "github.com/attestantio/go-block-relay"
+	"github.com/attestantio/go-block-relay/services/blockunblinder/standard"
+	"github.com/attestantio/go-block-relay/services/proposerdutiesprovider"
+	mockproposerdutiesprovider "github.com/attestantio/go-block-relay/services/proposerdutiesprovider/mock"
+	"github.com/attestantio/go-block-relay/signing"
+	"github.com/attestantio/go-block-relay/testing/builder"
+	builderapi "github.com/attestantio/go-builder-client/api"
+	builderapideneb "github.com/attestantio/go-builder-client/api/deneb"
+	builderapifulu "github.com/attestantio/go-builder-client/api/fulu"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
+	apiv1deneb "github.com/attestantio/go-eth2-client/api/v1/deneb"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/holiman/uint256"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// testPayload creates an execution payload with the given block hash.
+func testPayload(blockHash byte) *deneb.ExecutionPayload {
+	return &deneb.ExecutionPayload{
+		ParentHash:    phase0.Hash32{0x01},
+		FeeRecipient:  bellatrix.ExecutionAddress{0x02},
+		BlockNumber:   3,
+		GasLimit:      30000000,
+		GasUsed:       21000,
+		Timestamp:     1700000000,
+		BaseFeePerGas: uint256.NewInt(7),
+		BlockHash:     phase0.Hash32{blockHash},
+		Transactions:  []bellatrix.Transaction{{0x01, 0x02, 0x03}},
+		Withdrawals: []*capella.Withdrawal{
+			{Index: 1, ValidatorIndex: 2, Address: bellatrix.ExecutionAddress{0x03}, Amount: 4},
+		},
+	}
+}
+
+// testHeader creates the execution payload header for a payload.
+func testHeader(t *testing.T, payload *deneb.ExecutionPayload) *deneb.ExecutionPayloadHeader {
+	t.Helper()
+
+	hh := ssz.NewHasher()
+	index := hh.Index()
+	for _, tx := range payload.Transactions {
+		txIndex := hh.Index()
+		hh.AppendBytes32(tx)
+		hh.MerkleizeWithMixin(txIndex, uint64(len(tx)), (1073741824+31)/32)
+	}
+	hh.MerkleizeWithMixin(index, uint64(len(payload.Transactions)), 1048576)
+	transactionsRoot, err := hh.HashRoot()
+	require.NoError(t, err)
+
+	hh = ssz.NewHasher()
+	index = hh.Index()
+	for _, withdrawal := range payload.Withdrawals {
+		require.NoError(t, withdrawal.HashTreeRootWith(hh))
+	}
+	hh.MerkleizeWithMixin(index, uint64(len(payload.Withdrawals)), 16)
+	withdrawalsRoot, err := hh.HashRoot()
+	require.NoError(t, err)
+
+	return &deneb.ExecutionPayloadHeader{
+		ParentHash:       payload.ParentHash,
+		FeeRecipient:     payload.FeeRecipient,
+		StateRoot:        payload.StateRoot,
+		ReceiptsRoot:     payload.ReceiptsRoot,
+		LogsBloom:        payload.LogsBloom,
+		PrevRandao:       payload.PrevRandao,
+		BlockNumber:      payload.BlockNumber,
+		GasLimit:         payload.GasLimit,
+		GasUsed:          payload.GasUsed,
+		Timestamp:        payload.Timestamp,
+		ExtraData:        payload.ExtraData,
+		BaseFeePerGas:    payload.BaseFeePerGas,
+		BlockHash:        payload.BlockHash,
+		TransactionsRoot: transactionsRoot,
+		WithdrawalsRoot:  withdrawalsRoot,
+		BlobGasUsed:      payload.BlobGasUsed,
+		ExcessBlobGas:    payload.ExcessBlobGas,
+	}
+}
+
+func testCommitments() []deneb.KZGCommitment {
+	return []deneb.KZGCommitment{{0x01}, {0x02}}
+}
+
+func testDenebPayload(blockHash byte) *builderapi.VersionedSubmitBlindedBlockResponse {
+	return &builderapi.VersionedSubmitBlindedBlockResponse{
+		Version: spec.DataVersionDeneb,
+		Deneb: &builderapideneb.ExecutionPayloadAndBlobsBundle{
+			ExecutionPayload: testPayload(blockHash),
+			BlobsBundle: &builderapideneb.BlobsBundle{
+				Commitments: testCommitments(),
+				Proofs:      []deneb.KZGProof{{0x01}, {0x02}},
+				Blobs:       []deneb.Blob{{0x01}, {0x02}},
+			},
+		},
+	}
+}
+
+func testElectraPayload(blockHash byte) *builderapi.VersionedSubmitBlindedBlockResponse {
+	return &builderapi.VersionedSubmitBlindedBlockResponse{
+		Version: spec.DataVersionElectra,
+		Electra: &builderapideneb.ExecutionPayloadAndBlobsBundle{
+			ExecutionPayload: testPayload(blockHash),
+			BlobsBundle: &builderapideneb.BlobsBundle{
+				Commitments: testCommitments(),
+				Proofs:      []deneb.KZGProof{{0x01}, {0x02}},
+				Blobs:       []deneb.Blob{{0x01}, {0x02}},
+			},
+		},
+	}
+}
+
+func testFuluPayload(blockHash byte) *builderapi.VersionedSubmitBlindedBlockResponse {
+	return &builderapi.VersionedSubmitBlindedBlockResponse{
+		Version: spec.DataVersionFulu,
+		Fulu: &builderapifulu.ExecutionPayloadAndBlobsBundle{
+			ExecutionPayload: testPayload(blockHash),
+			BlobsBundle: &builderapifulu.BlobsBundle{
+				Commitments: testCommitments(),
+				Proofs:      []deneb.KZGProof{{0x01}, {0x02}, {0x03}},
+				Blobs:       []deneb.Blob{{0x01}, {0x02}},
+			},
+		},
+	}
+}
+
+var (
+	testForkVersions = map[spec.DataVersion]phase0.Version{
+		spec.DataVersionDeneb:   {0x04},
+		spec.DataVersionElectra: {0x05},
+		spec.DataVersionFulu:    {0x06},
+	}
+	testGenesisValidatorsRoot = phase0.Root{0x01}
+	testProposerSeed          = byte(0x20)
+)
+
+// testProposerPubkey provides the public key of the test proposer.
+func testProposerPubkey(t *testing.T) phase0.BLSPubKey {
+	t.Helper()
+
+	pubkey, err := signing.PublicKey(builder.SecretKey(testProposerSeed))
+	require.NoError(t, err)
+
+	return pubkey
+}
+
+// testDuties provides proposer duties for the test proposer in the given slots.
+func testDuties(t *testing.T, slots ...phase0.Slot) proposerdutiesprovider.Service {
+	t.Helper()
+
+	duties := make([]*apiv1.ProposerDuty, 0, len(slots))
+	for _, slot := range slots {
+		duties = append(duties, &apiv1.ProposerDuty{
+			PubKey:         testProposerPubkey(t),
+			Slot:           slot,
+			ValidatorIndex: 5,
+		})
+	}
+
+	return mockproposerdutiesprovider.New(duties...)
+}
+
+// testService creates an unblinder with the test chain configuration.
+func testService(ctx context.Context,
+	t *testing.T,
+	dutiesProvider proposerdutiesprovider.Service,
+	params ...standard.Parameter,
+) *standard.Service {
+	t.Helper()
+
+	s, err := standard.New(ctx, append([]standard.Parameter{
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithProposerDutiesProvider(dutiesProvider),
+		standard.WithForkVersions(testForkVersions),
+		standard.WithGenesisValidatorsRoot(testGenesisValidatorsRoot),
+	}, params...)...)
+	require.NoError(t, err)
+
+	return s
+}
+
+// signBlock signs a blinded block with the secret key derived from the given seed.
+func signBlock(t *testing.T, seed byte, block *api.VersionedSignedBlindedBeaconBlock) *api.VersionedSignedBlindedBeaconBlock {
+	t.Helper()
+
+	domain, err := signing.ComputeDomain(signing.DomainBeaconProposer,
+		testForkVersions[block.Version],
+		testGenesisValidatorsRoot,
+	)
+	require.NoError(t, err)
+	root, err := block.Root()
+	require.NoError(t, err)
+	signingRoot, err := signing.ComputeSigningRoot(root, domain)
+	require.NoError(t, err)
+	signature, err := signing.Sign(builder.SecretKey(seed), signingRoot)
+	require.NoError(t, err)
+
+	switch block.Version {
+	case spec.DataVersionDeneb:
+		block.Deneb.Signature = signature
+	case spec.DataVersionElectra:
+		block.Electra.Signature = signature
+	case spec.DataVersionFulu:
+		block.Fulu.Signature = signature
+	default:
+		require.Fail(t, "unexpected version")
+	}
+
+	return block
+}
+
+func testDenebBlock(t *testing.T, slot phase0.Slot, blockHash byte) *api.VersionedSignedBlindedBeaconBlock {
+	t.Helper()
+
+	return signBlock(t, testProposerSeed, &api.VersionedSignedBlindedBeaconBlock{
+		Version: spec.DataVersionDeneb,
+		Deneb: &apiv1deneb.SignedBlindedBeaconBlock{
+			Message: &apiv1deneb.BlindedBeaconBlock{
+				Slot:          slot,
+				ProposerIndex: 5,
+				Body: &apiv1deneb.BlindedBeaconBlockBody{
+					ETH1Data: &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+					SyncAggregate: &altair.SyncAggregate{
+						SyncCommitteeBits: bitfield.NewBitvector512(),
+					},
+					ExecutionPayloadHeader: testHeader(t, testPayload(blockHash)),
+					BlobKZGCommitments:     testCommitments(),
+				},
+			},
+		},
+	})
+}
+
+func testElectraBlock(t *testing.T, slot phase0.Slot, blockHash byte) *apiv1electra.SignedBlindedBeaconBlock {
+	t.Helper()
+
+	return &apiv1electra.SignedBlindedBeaconBlock{
+		Message: &apiv1electra.BlindedBeaconBlock{
+			Slot:          slot,
+			ProposerIndex: 5,
+			Body: &apiv1electra.BlindedBeaconBlockBody{
+				ETH1Data: &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+				SyncAggregate: &altair.SyncAggregate{
+					SyncCommitteeBits: bitfield.NewBitvector512(),
+				},
+				ExecutionPayloadHeader: testHeader(t, testPayload(blockHash)),
+				BlobKZGCommitments:     testCommitments(),
+				ExecutionRequests:      &electra.ExecutionRequests{},
+			},
+		},
+	}
+}
+
+// proposalRoot calculates the root of the block in a proposal.
+func proposalRoot(t *testing.T, proposal *api.VersionedSignedProposal) phase0.Root {
+	t.Helper()
+
+	var root phase0.Root
+	var err error
+	switch proposal.Version {
+	case spec.DataVersionDeneb:
+		root, err = proposal.Deneb.SignedBlock.Message.HashTreeRoot()
+	case spec.DataVersionElectra:
+		root, err = proposal.Electra.SignedBlock.Message.HashTreeRoot()
+	case spec.DataVersionFulu:
+		root, err = proposal.Fulu.SignedBlock.Message.HashTreeRoot()
+	default:
+		require.Fail(t, "unexpected version")
+	}
+	require.NoError(t, err)
+
+	return root
+}
+
+func TestUnblindBlock(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		dutiesProvider proposerdutiesprovider.Service
+		pubkey         *phase0.BLSPubKey
+		payload        *builderapi.VersionedSubmitBlindedBlockResponse
+		block          *api.VersionedSignedBlindedBeaconBlock
+		err            string
+	}{
+		{
+			name:    "Deneb",
+			payload: testDenebPayload(0x10),
+			block:   testDenebBlock(t, 10, 0x10),
+		},
+		{
+			name:    "Electra",
+			payload: testElectraPayload(0x10),
+			block: signBlock(t, testProposerSeed, &api.VersionedSignedBlindedBeaconBlock{
+				Version: spec.DataVersionElectra,
+				Electra: testElectraBlock(t, 10, 0x10),
+			}),
+		},
+		{
+			name:    "Fulu",
+			payload: testFuluPayload(0x10),
+			block: signBlock(t, testProposerSeed, &api.VersionedSignedBlindedBeaconBlock{
+				Version: spec.DataVersionFulu,
+				Fulu:    testElectraBlock(t, 10, 0x10),
+			}),
+		},
+		{
+			name:    "Capella",
+			payload: testDenebPayload(0x10),
+			block: &api.VersionedSignedBlindedBeaconBlock{
+				Version: spec.DataVersionCapella,
+				Capella: &apiv1capella.SignedBlindedBeaconBlock{
+					Message: &apiv1capella.BlindedBeaconBlock{
+						Slot: 10,
+						Body: &apiv1capella.BlindedBeaconBlockBody{
+							ExecutionPayloadHeader: &capella.ExecutionPayloadHeader{
+								BlockHash: phase0.Hash32{0x10},
+							},
+						},
+					},
+				},
+			},
+			err: "unsupported block version capella: invalid options",
+		},
+		{
+			name:    "BlockHashUnknown",
+			payload: testDenebPayload(0x10),
+			block:   testDenebBlock(t, 10, 0x11),
+			err:     "no payload for block hash 0x1100000000000000000000000000000000000000000000000000000000000000: invalid options",
+		},
+		{
+			name:    "SlotMismatch",
+			payload: testDenebPayload(0x10),
+			block:   testDenebBlock(t, 11, 0x10),
+			err:     "block slot 11 does not match payload slot 10: invalid options",
+		},
+		{
+			name:    "VersionMismatch",
+			payload: testElectraPayload(0x10),
+			block:   testDenebBlock(t, 10, 0x10),
+			err:     "block version deneb does not match payload version electra: invalid options",
+		},
+		{
+			name:    "HeaderMismatch",
+			payload: testDenebPayload(0x10),
+			block: func() *api.VersionedSignedBlindedBeaconBlock {
+				block := testDenebBlock(t, 10, 0x10)
+				block.Deneb.Message.Body.ExecutionPayloadHeader.GasLimit++
+
+				return signBlock(t, testProposerSeed, block)
+			}(),
+			err: "execution payload header does not match payload: invalid options",
+		},
+		{
+			name:    "CommitmentsMissing",
+			payload: testDenebPayload(0x10),
+			block: func() *api.VersionedSignedBlindedBeaconBlock {
+				block := testDenebBlock(t, 10, 0x10)
+				block.Deneb.Message.Body.BlobKZGCommitments = block.Deneb.Message.Body.BlobKZGCommitments[:1]
+
+				return signBlock(t, testProposerSeed, block)
+			}(),
+			err: "block has 1 blob commitments, payload has 2: invalid options",
+		},
+		{
+			name:    "CommitmentsMismatch",
+			payload: testDenebPayload(0x10),
+			block: func() *api.VersionedSignedBlindedBeaconBlock {
+				block := testDenebBlock(t, 10, 0x10)
+				block.Deneb.Message.Body.BlobKZGCommitments[1] = deneb.KZGCommitment{0x03}
+
+				return signBlock(t, testProposerSeed, block)
+			}(),
+			err: "blob commitment 1 does not match payload: invalid options",
+		},
+		{
+			name:           "DutyMissing",
+			dutiesProvider: testDuties(t, 11),
+			payload:        testDenebPayload(0x10),
+			block:          testDenebBlock(t, 10, 0x10),
+			err:            "no proposer duty for slot 10: invalid options",
+		},
+		{
+			name:    "ProposerIndexMismatch",
+			payload: testDenebPayload(0x10),
+			block: func() *api.VersionedSignedBlindedBeaconBlock {
+				block := testDenebBlock(t, 10, 0x10)
+				block.Deneb.Message.ProposerIndex = 6
+
+				return signBlock(t, testProposerSeed, block)
+			}(),
+			err: "block proposer index 6 does not match proposer index 5 for slot: invalid options",
+		},
+		{
+			name:    "BidRecipientMismatch",
+			pubkey:  &phase0.BLSPubKey{0x01},
+			payload: testDenebPayload(0x10),
+			block:   testDenebBlock(t, 10, 0x10),
+			err:     "proposer " + testProposerPubkey(t).String() + " for slot does not match bid recipient 0x01" + strings.Repeat("00", 47) + ": invalid options",
+		},
+		{
+			name:    "SignatureInvalid",
+			payload: testDenebPayload(0x10),
+			block: func() *api.VersionedSignedBlindedBeaconBlock {
+				block := testDenebBlock(t, 10, 0x10)
+				block.Deneb.Signature = phase0.BLSSignature{0x01}
+
+				return block
+			}(),
+			err: "invalid signature: invalid options",
+		},
+		{
+			name:    "SignatureIncorrect",
+			payload: testDenebPayload(0x10),
+			block:   signBlock(t, 0x21, testDenebBlock(t, 10, 0x10)),
+			err:     "block signature does not verify: invalid options",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dutiesProvider := test.dutiesProvider
+			if dutiesProvider == nil {
+				dutiesProvider = testDuties(t, 10)
+			}
+			s := testService(ctx, t, dutiesProvider)
+
+			pubkey := testProposerPubkey(t)
+			if test.pubkey != nil {
+				pubkey = *test.pubkey
+			}
+			require.NoError(t, s.RecordPayload(ctx, 10, pubkey, test.payload))
+
+			proposal, err := s.UnblindBlock(ctx, test.block)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.ErrorIs(t, err, relay.ErrInvalidOptions)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.block.Version, proposal.Version)
+
+			blockHash, err := proposal.ExecutionBlockHash()
+			require.NoError(t, err)
+			require.Equal(t, phase0.Hash32{0x10}, blockHash)
+
+			// The unblinded block must have the same root as the blinded block.
+			blindedRoot, err := test.block.Root()
+			require.NoError(t, err)
+			root := proposalRoot(t, proposal)
+			require.Equal(t, blindedRoot, root)
+
+			require.NoError(t, proposal.AssertPresent())
+		})
+	}
+}
+
+func TestUnblindBlockDutiesErroring(t *testing.T) {
+	ctx := context.Background()
+
+	s := testService(ctx, t, mockproposerdutiesprovider.NewErroring())
+	require.NoError(t, s.RecordPayload(ctx, 10, testProposerPubkey(t), testDenebPayload(0x10)))
+
+	_, err := s.UnblindBlock(ctx, testDenebBlock(t, 10, 0x10))
+	require.EqualError(t, err, "failed to obtain proposer duties: error")
+	require.NotErrorIs(t, err, relay.ErrInvalidOptions)
+}
+
+func TestRecordPayload(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		payload *builderapi.VersionedSubmitBlindedBlockResponse
+		err     string
+	}{
+		{
+			name: "Nil",
+			err:  "no payload supplied",
+		},
+		{
+			name: "Empty",
+			payload: &builderapi.VersionedSubmitBlindedBlockResponse{
+				Version: spec.DataVersionElectra,
+			},
+			err: "payload is empty",
+		},
+		{
+			name: "UnsupportedVersion",
+			payload: &builderapi.VersionedSubmitBlindedBlockResponse{
+				Version: spec.DataVersionBellatrix,
+				Bellatrix: &bellatrix.ExecutionPayload{
+					BlockHash: phase0.Hash32{0x01},
+				},
+			},
+			err: "unsupported payload version bellatrix",
+		},
+		{
+			name: "Capella",
+			payload: &builderapi.VersionedSubmitBlindedBlockResponse{
+				Version: spec.DataVersionCapella,
+				Capella: &capella.ExecutionPayload{
+					BlockHash: phase0.Hash32{0x01},
+				},
+			},
+			err: "unsupported payload version capella",
+		},
+		{
+			name: "Incomplete",
+			payload: &builderapi.VersionedSubmitBlindedBlockResponse{
+				Version: spec.DataVersionElectra,
+				Electra: &builderapideneb.ExecutionPayloadAndBlobsBundle{
+					ExecutionPayload: testPayload(0x10),
+				},
+			},
+			err: "payload incomplete",
+		},
+		{
+			name:    "Good",
+			payload: testElectraPayload(0x10),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := testService(ctx, t, testDuties(t, 10))
+
+			err := s.RecordPayload(ctx, 10, testProposerPubkey(t), test.payload)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPrune(t *testing.T) {
+	ctx := context.Background()
+
+	s := testService(ctx, t, testDuties(t, 10, 14, 15), standard.WithRetentionSlots(4))
+	pubkey := testProposerPubkey(t)
+
+	require.NoError(t, s.RecordPayload(ctx, 10, pubkey, testDenebPayload(0x10)))
+	require.NoError(t, s.RecordPayload(ctx, 14, pubkey, testDenebPayload(0x14)))
+
+	// Payload for slot 10 is still within the retention period.
+	_, err := s.UnblindBlock(ctx, testDenebBlock(t, 10, 0x10))
+	require.NoError(t, err)
+
+	require.NoError(t, s.RecordPayload(ctx, 15, pubkey, testDenebPayload(0x15)))
+
+	// Payload for slot 10 has now been pruned.
+	_, err = s.UnblindBlock(ctx, testDenebBlock(t, 10, 0x10))
+	require.ErrorIs(t, err, relay.ErrInvalidOptions)
+
+	_, err = s.UnblindBlock(ctx, testDenebBlock(t, 14, 0x14))
+	require.NoError(t, err)
+}
@@ -1,4 +1,4 @@
-// Copyright © 2022 Attestant Limited.
+// Copyright © 2022, 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -16,8 +16,15 @@ package mock
 import (
 	"context"
 
+	builderapi "github.com/attestantio/go-builder-client/api"
+	builderapideneb "github.com/attestantio/go-builder-client/api/deneb"
+	"github.com/attestantio/go-builder-client/api/electra"
 	"github.com/attestantio/go-builder-client/spec"
+	consensusspec "github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	consensuselectra "github.com/attestantio/go-eth2-client/spec/electra"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
 )
 
 // Service is a mock block auctioneer.
@@ -37,5 +44,42 @@ func (s *Service) BuilderBid(_ context.Context,
 	*spec.VersionedSignedBuilderBid,
 	error,
 ) {
-	return &spec.VersionedSignedBuilderBid{}, nil
+	return &spec.VersionedSignedBuilderBid{
+		Version: consensusspec.DataVersionElectra,
+		Electra: &electra.SignedBuilderBid{
+			Message: &electra.BuilderBid{
+				Header: &deneb.ExecutionPayloadHeader{
+					BaseFeePerGas: uint256.NewInt(0),
+				},
+				BlobKZGCommitments: make([]deneb.KZGCommitment, 0),
+				ExecutionRequests:  &consensuselectra.ExecutionRequests{},
+				Value:              uint256.NewInt(0),
+			},
+		},
+	}, nil
+}
+
+// ExecutionPayload provides the execution payload and blobs bundle for the bid with the given block hash.
+func (s *Service) ExecutionPayload(_ context.Context,
+	_ phase0.Slot,
+	blockHash phase0.Hash32,
+) (
+	*builderapi.VersionedSubmitBlindedBlockResponse,
+	error,
+) {
+	return &builderapi.VersionedSubmitBlindedBlockResponse{
+		Version: consensusspec.DataVersionElectra,
+		Electra: &builderapideneb.ExecutionPayloadAndBlobsBundle{
+			ExecutionPayload: &deneb.ExecutionPayload{
+				BaseFeePerGas: uint256.NewInt(0),
+				BlockHash:     blockHash,
+			},
+			BlobsBundle: &builderapideneb.BlobsBundle{},
+		},
+	}, nil
+}
+
+// Healthy returns true if the service is able to handle requests.
+func (s *Service) Healthy(_ context.Context) bool {
+	return true
 }
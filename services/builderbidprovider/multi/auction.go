@@ -0,0 +1,301 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/attestantio/go-block-relay/loggers"
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+	"github.com/attestantio/go-block-relay/signing"
+	builderclient "github.com/attestantio/go-builder-client"
+	"github.com/attestantio/go-builder-client/api"
+	"github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// bidResponse is the response from a single upstream provider.
+type bidResponse struct {
+	provider builderclient.BuilderBidProvider
+	bid      *spec.VersionedSignedBuilderBid
+	score    *big.Int
+}
+
+// BuilderBid provides the highest-value valid bid from the upstream providers.
+// If no valid bids are received it returns nil.
+func (s *Service) BuilderBid(ctx context.Context,
+	slot phase0.Slot,
+	parentHash phase0.Hash32,
+	pubkey phase0.BLSPubKey,
+) (
+	*spec.VersionedSignedBuilderBid,
+	error,
+) {
+	res, err := s.AuctionBlock(ctx, slot, parentHash, pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.WinningParticipation == nil {
+		return nil, nil
+	}
+
+	return res.WinningParticipation.Bid, nil
+}
+
+// AuctionBlock obtains the best available use of the block space.
+func (s *Service) AuctionBlock(ctx context.Context,
+	slot phase0.Slot,
+	parentHash phase0.Hash32,
+	pubkey phase0.BLSPubKey,
+) (
+	*blockauctioneer.Results,
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.go-block-relay.services.builderbidprovider.multi").Start(ctx, "AuctionBlock", trace.WithAttributes(
+		attribute.Int64("slot", int64(slot)),
+		attribute.String("pubkey", pubkey.String()),
+	))
+	defer span.End()
+
+	log := loggers.WithRequestID(ctx, s.log)
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	opts := &api.BuilderBidOpts{
+		Slot:       slot,
+		ParentHash: parentHash,
+		PubKey:     pubkey,
+	}
+
+	// Channel is buffered so that late responses do not block their goroutines.
+	respCh := make(chan *bidResponse, len(s.builderBidProviders))
+	for _, provider := range s.builderBidProviders {
+		go s.bid(ctx, provider, opts, respCh)
+	}
+
+	res := &blockauctioneer.Results{
+		Participation: make(map[string]*blockauctioneer.Participation),
+		AllProviders:  s.builderBidProviders,
+		Providers:     make([]builderclient.BuilderBidProvider, 0),
+	}
+
+	responses := make([]*bidResponse, 0, len(s.builderBidProviders))
+	for range s.builderBidProviders {
+		select {
+		case resp := <-respCh:
+			responses = append(responses, resp)
+		case <-ctx.Done():
+			log.Debug().
+				Uint64("slot", uint64(slot)).
+				Int("responses", len(responses)).
+				Int("providers", len(s.builderBidProviders)).
+				Msg("Timed out waiting for bids")
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	var winner *bidResponse
+	for _, resp := range responses {
+		if resp.bid == nil {
+			continue
+		}
+
+		res.Participation[resp.provider.Name()] = &blockauctioneer.Participation{
+			Score: resp.score,
+			Bid:   resp.bid,
+		}
+
+		// Ties are retained by the earliest response.
+		if winner == nil || resp.score.Cmp(winner.score) > 0 {
+			winner = resp
+		}
+	}
+
+	if winner == nil {
+		log.Debug().Uint64("slot", uint64(slot)).Msg("No valid bids received")
+
+		return res, nil
+	}
+
+	res.WinningParticipation = res.Participation[winner.provider.Name()]
+
+	winningBlockHash, err := winner.bid.BlockHash()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain winning block hash")
+	}
+
+	for _, resp := range responses {
+		if resp.bid == nil {
+			continue
+		}
+
+		blockHash, err := resp.bid.BlockHash()
+		if err != nil {
+			continue
+		}
+
+		if blockHash == winningBlockHash {
+			res.Providers = append(res.Providers, resp.provider)
+		}
+	}
+
+	log.Trace().
+		Uint64("slot", uint64(slot)).
+		Str("provider", winner.provider.Name()).
+		Stringer("score", winner.score).
+		Msg("Selected winning bid")
+
+	return res, nil
+}
+
+// bid obtains a bid from a single upstream provider, always sending a response.
+func (s *Service) bid(ctx context.Context,
+	provider builderclient.BuilderBidProvider,
+	opts *api.BuilderBidOpts,
+	respCh chan<- *bidResponse,
+) {
+	ctx, span := otel.Tracer("attestantio.go-block-relay.services.builderbidprovider.multi").Start(ctx, "BuilderBid", trace.WithAttributes(
+		attribute.String("provider", provider.Name()),
+		attribute.Int64("slot", int64(opts.Slot)),
+	))
+	defer span.End()
+
+	log := loggers.WithRequestID(ctx, s.log).With().Str("provider", provider.Name()).Uint64("slot", uint64(opts.Slot)).Logger()
+	resp := &bidResponse{
+		provider: provider,
+	}
+	defer func() {
+		respCh <- resp
+	}()
+
+	started := time.Now()
+
+	bidResp, err := provider.BuilderBid(ctx, opts)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to obtain bid")
+		span.SetStatus(codes.Error, err.Error())
+		s.monitorBid(provider.Name(), "failed", time.Since(started))
+		// A request that was cancelled or timed out says nothing about the provider's health.
+		if !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+			s.setProviderHealth(provider.Name(), false)
+		}
+
+		return
+	}
+
+	received := time.Now()
+
+	// Any response, even one without a valid bid, shows that the provider is reachable.
+	s.setProviderHealth(provider.Name(), true)
+
+	if bidResp == nil || bidResp.Data == nil {
+		log.Trace().Msg("No bid returned")
+		s.monitorBid(provider.Name(), "none", time.Since(started))
+
+		return
+	}
+
+	score, err := s.verifyBid(provider, bidResp.Data, opts.ParentHash)
+	if err != nil {
+		log.Debug().Err(err).Msg("Invalid bid")
+		span.SetStatus(codes.Error, err.Error())
+		s.monitorBid(provider.Name(), "invalid", time.Since(started))
+
+		return
+	}
+
+	s.monitorBid(provider.Name(), "succeeded", time.Since(started))
+	s.recordReceivedBid(ctx, opts, bidResp.Data, received)
+
+	resp.bid = bidResp.Data
+	resp.score = score
+}
+
+// recordReceivedBid records a valid bid with the bid trace recorder, if present.
+func (s *Service) recordReceivedBid(ctx context.Context,
+	opts *api.BuilderBidOpts,
+	bid *spec.VersionedSignedBuilderBid,
+	received time.Time,
+) {
+	if s.bidTraceRecorder == nil {
+		return
+	}
+
+	// Bids obtained from upstream providers are never optimistic.
+	err := s.bidTraceRecorder.RecordReceivedBid(ctx, opts.Slot, opts.PubKey, bid, received, false)
+	if err != nil {
+		log := loggers.WithRequestID(ctx, s.log)
+		log.Warn().Err(err).Uint64("slot", uint64(opts.Slot)).Msg("Failed to record received bid")
+	}
+}
+
+// verifyBid verifies a bid, returning its score if valid.
+func (s *Service) verifyBid(provider builderclient.BuilderBidProvider,
+	bid *spec.VersionedSignedBuilderBid,
+	parentHash phase0.Hash32,
+) (
+	*big.Int,
+	error,
+) {
+	if bid.IsEmpty() {
+		return nil, errors.New("bid is empty")
+	}
+
+	bidParentHash, err := bid.ParentHash()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain parent hash")
+	}
+
+	if bidParentHash != parentHash {
+		return nil, fmt.Errorf("bid parent hash %#x does not match requested parent hash %#x", bidParentHash, parentHash)
+	}
+
+	value, err := bid.Value()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain value")
+	}
+
+	if value.IsZero() {
+		return nil, errors.New("bid has zero value")
+	}
+
+	builder, err := bid.Builder()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain builder")
+	}
+
+	if providerPubkey := provider.Pubkey(); providerPubkey != nil && *providerPubkey != builder {
+		return nil, fmt.Errorf("bid builder %#x does not match provider %#x", builder, *providerPubkey)
+	}
+
+	err = signing.VerifyBuilderBid(bid, s.builderDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	return value.ToBig(), nil
+}
@@ -0,0 +1,226 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-block-relay/services/bidtracerecorder"
+	standardbidtracerecorder "github.com/attestantio/go-block-relay/services/bidtracerecorder/standard"
+	"github.com/attestantio/go-block-relay/services/builderbidprovider/multi"
+	"github.com/attestantio/go-block-relay/testing/builder"
+	builderclient "github.com/attestantio/go-builder-client"
+	"github.com/attestantio/go-builder-client/api/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+var testParentHash = phase0.Hash32{0x01}
+
+func TestAuctionBlock(t *testing.T) {
+	ctx := context.Background()
+
+	low := builder.NewProvider(t, "low", 0x01, 1000)
+	high := builder.NewProvider(t, "high", 0x02, 2000)
+	highDuplicate := builder.NewProvider(t, "high duplicate", 0x03, 2000)
+	highDuplicate.BlockHash = high.BlockHash
+	erroring := builder.NewProvider(t, "erroring", 0x04, 3000)
+	erroring.Err = errors.New("error")
+	slow := builder.NewProvider(t, "slow", 0x05, 4000)
+	slow.Delay = time.Second
+	tampered := builder.NewProvider(t, "tampered", 0x06, 5000)
+	tampered.Tamper = func(bid *electra.SignedBuilderBid) {
+		bid.Message.Value = uint256.NewInt(6000)
+	}
+	zero := builder.NewProvider(t, "zero", 0x07, 0)
+	impostor := builder.NewProvider(t, "impostor", 0x08, 7000)
+	impostor.PubkeyValue = high.PubkeyValue
+
+	tests := []struct {
+		name          string
+		providers     []builderclient.BuilderBidProvider
+		participants  []string
+		winner        string
+		value         uint64
+		winProviders  []string
+		noWinningBids bool
+	}{
+		{
+			name:          "NoBids",
+			providers:     []builderclient.BuilderBidProvider{erroring},
+			participants:  []string{},
+			noWinningBids: true,
+		},
+		{
+			name:         "Single",
+			providers:    []builderclient.BuilderBidProvider{low},
+			participants: []string{"low"},
+			winner:       "low",
+			value:        1000,
+			winProviders: []string{"low"},
+		},
+		{
+			name:         "HighestValue",
+			providers:    []builderclient.BuilderBidProvider{low, high},
+			participants: []string{"low", "high"},
+			winner:       "high",
+			value:        2000,
+			winProviders: []string{"high"},
+		},
+		{
+			name:         "DuplicateBlock",
+			providers:    []builderclient.BuilderBidProvider{low, high, highDuplicate},
+			participants: []string{"low", "high", "high duplicate"},
+			value:        2000,
+			winProviders: []string{"high", "high duplicate"},
+		},
+		{
+			name:         "InvalidExcluded",
+			providers:    []builderclient.BuilderBidProvider{low, erroring, tampered, zero, impostor},
+			participants: []string{"low"},
+			winner:       "low",
+			value:        1000,
+			winProviders: []string{"low"},
+		},
+		{
+			name:         "SlowExcluded",
+			providers:    []builderclient.BuilderBidProvider{low, slow},
+			participants: []string{"low"},
+			winner:       "low",
+			value:        1000,
+			winProviders: []string{"low"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := multi.New(ctx,
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithTimeout(200*time.Millisecond),
+				multi.WithBuilderBidProviders(test.providers),
+			)
+			require.NoError(t, err)
+
+			started := time.Now()
+			res, err := s.AuctionBlock(ctx, 1, testParentHash, phase0.BLSPubKey{})
+			require.NoError(t, err)
+			require.Less(t, time.Since(started), time.Second)
+
+			require.Len(t, res.AllProviders, len(test.providers))
+			require.Len(t, res.Participation, len(test.participants))
+			for _, participant := range test.participants {
+				require.Contains(t, res.Participation, participant)
+			}
+
+			if test.noWinningBids {
+				require.Nil(t, res.WinningParticipation)
+				require.Empty(t, res.Providers)
+
+				bid, err := s.BuilderBid(ctx, 1, testParentHash, phase0.BLSPubKey{})
+				require.NoError(t, err)
+				require.Nil(t, bid)
+
+				return
+			}
+
+			require.NotNil(t, res.WinningParticipation)
+			require.Equal(t, test.value, res.WinningParticipation.Score.Uint64())
+			if test.winner != "" {
+				require.Equal(t, res.Participation[test.winner], res.WinningParticipation)
+			}
+
+			winProviders := make([]string, 0, len(res.Providers))
+			for _, provider := range res.Providers {
+				winProviders = append(winProviders, provider.Name())
+			}
+			require.ElementsMatch(t, test.winProviders, winProviders)
+
+			bid, err := s.BuilderBid(ctx, 1, testParentHash, phase0.BLSPubKey{})
+			require.NoError(t, err)
+			value, err := bid.Value()
+			require.NoError(t, err)
+			require.Equal(t, test.value, value.Uint64())
+		})
+	}
+}
+
+func TestAuctionBlockParentHashMismatch(t *testing.T) {
+	ctx := context.Background()
+
+	// Upstream echoes the requested parent hash, so tamper with it afterwards.
+	upstream := builder.NewProvider(t, "mismatch", 0x01, 1000)
+	upstream.Tamper = func(bid *electra.SignedBuilderBid) {
+		bid.Message.Header.ParentHash = phase0.Hash32{0x02}
+	}
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithBuilderBidProviders([]builderclient.BuilderBidProvider{upstream}),
+	)
+	require.NoError(t, err)
+
+	bid, err := s.BuilderBid(ctx, 1, testParentHash, phase0.BLSPubKey{})
+	require.NoError(t, err)
+	require.Nil(t, bid)
+}
+
+func TestAuctionBlockRecordsBids(t *testing.T) {
+	ctx := context.Background()
+
+	low := builder.NewProvider(t, "low", 0x01, 1000)
+	high := builder.NewProvider(t, "high", 0x02, 2000)
+	erroring := builder.NewProvider(t, "erroring", 0x03, 3000)
+	erroring.Err = errors.New("error")
+	tampered := builder.NewProvider(t, "tampered", 0x04, 4000)
+	tampered.Tamper = func(bid *electra.SignedBuilderBid) {
+		bid.Message.Value = uint256.NewInt(5000)
+	}
+
+	recorder, err := standardbidtracerecorder.New(ctx,
+		standardbidtracerecorder.WithLogLevel(zerolog.Disabled),
+	)
+	require.NoError(t, err)
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithTimeout(200*time.Millisecond),
+		multi.WithBuilderBidProviders([]builderclient.BuilderBidProvider{low, high, erroring, tampered}),
+		multi.WithBidTraceRecorder(recorder),
+	)
+	require.NoError(t, err)
+
+	proposerPubkey := phase0.BLSPubKey{0x05}
+	_, err = s.AuctionBlock(ctx, 1, testParentHash, proposerPubkey)
+	require.NoError(t, err)
+
+	// Only valid bids are recorded.
+	slot := phase0.Slot(1)
+	traces, err := recorder.ReceivedBids(ctx, &bidtracerecorder.ReceivedBidsFilter{Slot: &slot})
+	require.NoError(t, err)
+	require.Len(t, traces, 2)
+
+	builders := make([]phase0.BLSPubKey, 0, len(traces))
+	for _, trace := range traces {
+		builders = append(builders, trace.BuilderPubkey)
+		require.Equal(t, proposerPubkey, trace.ProposerPubkey)
+		require.Equal(t, testParentHash, trace.ParentHash)
+		require.False(t, trace.OptimisticSubmission)
+		require.False(t, trace.Timestamp.IsZero())
+	}
+	require.ElementsMatch(t, []phase0.BLSPubKey{low.PubkeyValue, high.PubkeyValue}, builders)
+}
@@ -0,0 +1,55 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	"github.com/attestantio/go-block-relay/loggers"
+	"github.com/attestantio/go-block-relay/services/builderbidprovider"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BidBuilder provides the public key of the builder of the bid with the given block hash,
+// from the first upstream provider that supplies builders and knows of the bid.
+// It returns nil if the builder is not known.
+func (s *Service) BidBuilder(ctx context.Context,
+	slot phase0.Slot,
+	blockHash phase0.Hash32,
+) (
+	*phase0.BLSPubKey,
+	error,
+) {
+	log := loggers.WithRequestID(ctx, s.log)
+
+	for _, provider := range s.builderBidProviders {
+		builderProvider, isBuilderProvider := provider.(builderbidprovider.BidBuilderProvider)
+		if !isBuilderProvider {
+			continue
+		}
+
+		builder, err := builderProvider.BidBuilder(ctx, slot, blockHash)
+		if err != nil {
+			log.Debug().Str("provider", provider.Name()).Err(err).Msg("Failed to obtain builder from provider")
+
+			continue
+		}
+
+		if builder != nil {
+			return builder, nil
+		}
+	}
+
+	return nil, nil
+}
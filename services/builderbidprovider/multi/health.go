@@ -0,0 +1,47 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"time"
+)
+
+// Healthy returns true if the service is able to handle requests.
+// The service is healthy if at least one of its providers has not failed within the health
+// recovery period, so that a provider that failed is retried rather than excluded for good.
+func (s *Service) Healthy(_ context.Context) bool {
+	s.providerHealthMu.RLock()
+	defer s.providerHealthMu.RUnlock()
+
+	for _, provider := range s.builderBidProviders {
+		failed, exists := s.providerFailures[provider.Name()]
+		if !exists || time.Since(failed) >= s.healthRecovery {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setProviderHealth sets the health of a provider.
+func (s *Service) setProviderHealth(name string, healthy bool) {
+	s.providerHealthMu.Lock()
+	if healthy {
+		delete(s.providerFailures, name)
+	} else {
+		s.providerFailures[name] = time.Now()
+	}
+	s.providerHealthMu.Unlock()
+}
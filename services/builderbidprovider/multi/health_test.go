@@ -0,0 +1,115 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-block-relay/services/builderbidprovider/multi"
+	"github.com/attestantio/go-block-relay/testing/builder"
+	builderclient "github.com/attestantio/go-builder-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthy(t *testing.T) {
+	ctx := context.Background()
+
+	first := builder.NewProvider(t, "first", 0x01, 1000)
+	second := builder.NewProvider(t, "second", 0x02, 2000)
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithTimeout(200*time.Millisecond),
+		multi.WithBuilderBidProviders([]builderclient.BuilderBidProvider{first, second}),
+	)
+	require.NoError(t, err)
+
+	// Healthy before any requests.
+	require.True(t, s.Healthy(ctx))
+
+	// Healthy while at least one provider responds.
+	first.Err = errors.New("error")
+	_, err = s.AuctionBlock(ctx, 1, testParentHash, phase0.BLSPubKey{})
+	require.NoError(t, err)
+	require.True(t, s.Healthy(ctx))
+
+	// Unhealthy when no providers respond.
+	second.Err = errors.New("error")
+	_, err = s.AuctionBlock(ctx, 1, testParentHash, phase0.BLSPubKey{})
+	require.NoError(t, err)
+	require.False(t, s.Healthy(ctx))
+
+	// Healthy again when a provider recovers.
+	second.Err = nil
+	_, err = s.AuctionBlock(ctx, 1, testParentHash, phase0.BLSPubKey{})
+	require.NoError(t, err)
+	require.True(t, s.Healthy(ctx))
+}
+
+func TestHealthyRecovery(t *testing.T) {
+	ctx := context.Background()
+
+	provider := builder.NewProvider(t, "provider", 0x01, 1000)
+	provider.Err = errors.New("error")
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithTimeout(200*time.Millisecond),
+		multi.WithHealthRecovery(100*time.Millisecond),
+		multi.WithBuilderBidProviders([]builderclient.BuilderBidProvider{provider}),
+	)
+	require.NoError(t, err)
+
+	_, err = s.AuctionBlock(ctx, 1, testParentHash, phase0.BLSPubKey{})
+	require.NoError(t, err)
+	require.False(t, s.Healthy(ctx))
+
+	// Healthy again once the recovery period has passed without further failures.
+	time.Sleep(150 * time.Millisecond)
+	require.True(t, s.Healthy(ctx))
+}
+
+func TestHealthyTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	provider := builder.NewProvider(t, "provider", 0x01, 1000)
+	provider.Delay = time.Second
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithTimeout(50*time.Millisecond),
+		multi.WithBuilderBidProviders([]builderclient.BuilderBidProvider{provider}),
+	)
+	require.NoError(t, err)
+
+	_, err = s.AuctionBlock(ctx, 1, testParentHash, phase0.BLSPubKey{})
+	require.NoError(t, err)
+
+	// A request that timed out does not count against the provider.
+	time.Sleep(50 * time.Millisecond)
+	require.True(t, s.Healthy(ctx))
+
+	// Nor does a request that was cancelled.
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = s.AuctionBlock(cancelledCtx, 1, testParentHash, phase0.BLSPubKey{})
+	require.NoError(t, err)
+	time.Sleep(50 * time.Millisecond)
+	require.True(t, s.Healthy(ctx))
+}
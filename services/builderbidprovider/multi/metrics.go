@@ -0,0 +1,88 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"time"
+
+	"github.com/attestantio/go-block-relay/services/metrics"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var metricsNamespace = "blockrelay"
+
+// serviceMetrics are the metrics for a single instance of the service.
+type serviceMetrics struct {
+	bids         *prometheus.CounterVec
+	bidDurations *prometheus.HistogramVec
+}
+
+// registerMetrics registers the service's metrics with the monitor.
+// It returns nil if the monitor does not collect metrics.
+func registerMetrics(ctx context.Context, monitor metrics.Service) (*serviceMetrics, error) {
+	if monitor == nil {
+		// No monitor.
+		return nil, nil
+	}
+
+	registerer := monitor.Registerer()
+	if registerer == nil {
+		// Monitor does not collect metrics.
+		return nil, nil
+	}
+
+	return registerPrometheusMetrics(ctx, registerer)
+}
+
+func registerPrometheusMetrics(_ context.Context, registerer prometheus.Registerer) (*serviceMetrics, error) {
+	m := &serviceMetrics{}
+
+	m.bids = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "builderbidprovider",
+		Name:      "bids_total",
+		Help:      "Bids requested from upstream providers",
+	}, []string{"provider", "result"})
+
+	err := registerer.Register(m.bids)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register bids_total")
+	}
+
+	m.bidDurations = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "builderbidprovider",
+		Name:      "bid_duration_seconds",
+		Help:      "Time taken to obtain bids from upstream providers",
+		Buckets: []float64{
+			0.05, 0.1, 0.15, 0.2, 0.25, 0.3, 0.4, 0.5, 0.6, 0.8, 1.0, 1.5, 2.0, 3.0,
+		},
+	}, []string{"provider"})
+
+	err = registerer.Register(m.bidDurations)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register bid_duration_seconds")
+	}
+
+	return m, nil
+}
+
+func (s *Service) monitorBid(provider string, result string, duration time.Duration) {
+	if s.metrics != nil {
+		s.metrics.bids.WithLabelValues(provider, result).Inc()
+		s.metrics.bidDurations.WithLabelValues(provider).Observe(duration.Seconds())
+	}
+}
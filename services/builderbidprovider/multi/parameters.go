@@ -0,0 +1,138 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"errors"
+	"time"
+
+	"github.com/attestantio/go-block-relay/services/bidtracerecorder"
+	"github.com/attestantio/go-block-relay/services/metrics"
+	nullmetrics "github.com/attestantio/go-block-relay/services/metrics/null"
+	builderclient "github.com/attestantio/go-builder-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel            zerolog.Level
+	monitor             metrics.Service
+	timeout             time.Duration
+	genesisForkVersion  phase0.Version
+	builderBidProviders []builderclient.BuilderBidProvider
+	bidTraceRecorder    bidtracerecorder.ReceivedBidRecorder
+	healthRecovery      time.Duration
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithMonitor sets the monitor for the module.
+func WithMonitor(monitor metrics.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.monitor = monitor
+	})
+}
+
+// WithTimeout sets the maximum time to wait for bids from upstream providers.
+func WithTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.timeout = timeout
+	})
+}
+
+// WithGenesisForkVersion sets the genesis fork version of the chain, used to verify bid signatures.
+func WithGenesisForkVersion(version phase0.Version) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisForkVersion = version
+	})
+}
+
+// WithBuilderBidProviders sets the upstream providers of builder bids.
+func WithBuilderBidProviders(providers []builderclient.BuilderBidProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.builderBidProviders = providers
+	})
+}
+
+// WithBidTraceRecorder sets the recorder for bids received from upstream providers.
+// If not supplied received bids are not recorded.
+func WithBidTraceRecorder(recorder bidtracerecorder.ReceivedBidRecorder) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.bidTraceRecorder = recorder
+	})
+}
+
+// WithHealthRecovery sets the time after which a provider that failed to respond is
+// considered healthy again, absent further failures.
+func WithHealthRecovery(recovery time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.healthRecovery = recovery
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:       zerolog.GlobalLevel(),
+		monitor:        nullmetrics.New(),
+		timeout:        time.Second,
+		healthRecovery: time.Minute,
+	}
+
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.monitor == nil {
+		return nil, errors.New("no monitor specified")
+	}
+
+	if parameters.timeout <= 0 {
+		return nil, errors.New("timeout must be greater than 0")
+	}
+
+	if parameters.healthRecovery <= 0 {
+		return nil, errors.New("health recovery must be greater than 0")
+	}
+
+	if len(parameters.builderBidProviders) == 0 {
+		return nil, errors.New("no builder bid providers specified")
+	}
+
+	for _, provider := range parameters.builderBidProviders {
+		if provider == nil {
+			return nil, errors.New("nil builder bid provider specified")
+		}
+	}
+
+	return &parameters, nil
+}
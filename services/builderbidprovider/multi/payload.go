@@ -0,0 +1,56 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+
+	"github.com/attestantio/go-block-relay/loggers"
+	"github.com/attestantio/go-block-relay/services/builderbidprovider"
+	builderapi "github.com/attestantio/go-builder-client/api"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ExecutionPayload provides the execution payload and blobs bundle for the bid with the given block hash,
+// from the first upstream provider that supplies payloads and knows of the bid.
+// It returns nil if the payload is not known.
+func (s *Service) ExecutionPayload(ctx context.Context,
+	slot phase0.Slot,
+	blockHash phase0.Hash32,
+) (
+	*builderapi.VersionedSubmitBlindedBlockResponse,
+	error,
+) {
+	log := loggers.WithRequestID(ctx, s.log)
+
+	for _, provider := range s.builderBidProviders {
+		payloadProvider, isPayloadProvider := provider.(builderbidprovider.ExecutionPayloadProvider)
+		if !isPayloadProvider {
+			continue
+		}
+
+		payload, err := payloadProvider.ExecutionPayload(ctx, slot, blockHash)
+		if err != nil {
+			log.Debug().Str("provider", provider.Name()).Err(err).Msg("Failed to obtain payload from provider")
+
+			continue
+		}
+
+		if payload != nil {
+			return payload, nil
+		}
+	}
+
+	return nil, nil
+}
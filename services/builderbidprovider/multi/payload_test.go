@@ -0,0 +1,79 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/attestantio/go-block-relay/services/builderbidprovider/multi"
+	"github.com/attestantio/go-block-relay/testing/builder"
+	builderclient "github.com/attestantio/go-builder-client"
+	builderapi "github.com/attestantio/go-builder-client/api"
+	consensusspec "github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionPayload(t *testing.T) {
+	ctx := context.Background()
+
+	payload := &builderapi.VersionedSubmitBlindedBlockResponse{
+		Version: consensusspec.DataVersionElectra,
+	}
+
+	plain := builder.NewProvider(t, "plain", 0x01, 1000)
+	erroring := &builder.PayloadProvider{
+		Provider: builder.NewProvider(t, "erroring", 0x02, 2000),
+	}
+	erroring.Err = errors.New("error")
+	known := &builder.PayloadProvider{
+		Provider: builder.NewProvider(t, "known", 0x03, 3000),
+		Payloads: map[phase0.Hash32]*builderapi.VersionedSubmitBlindedBlockResponse{
+			{0x03}: payload,
+		},
+	}
+
+	s, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithBuilderBidProviders([]builderclient.BuilderBidProvider{plain, erroring, known}),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		blockHash phase0.Hash32
+		payload   *builderapi.VersionedSubmitBlindedBlockResponse
+	}{
+		{
+			name:      "Known",
+			blockHash: phase0.Hash32{0x03},
+			payload:   payload,
+		},
+		{
+			name:      "Unknown",
+			blockHash: phase0.Hash32{0x04},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res, err := s.ExecutionPayload(ctx, 1, test.blockHash)
+			require.NoError(t, err)
+			require.Equal(t, test.payload, res)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-block-relay/services/bidtracerecorder"
+	"github.com/attestantio/go-block-relay/signing"
+	builderclient "github.com/attestantio/go-builder-client"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a builder bid provider that obtains bids from multiple upstream providers.
+type Service struct {
+	log                 zerolog.Logger
+	metrics             *serviceMetrics
+	timeout             time.Duration
+	builderDomain       phase0.Domain
+	builderBidProviders []builderclient.BuilderBidProvider
+	bidTraceRecorder    bidtracerecorder.ReceivedBidRecorder
+	healthRecovery      time.Duration
+
+	providerHealthMu sync.RWMutex
+	providerFailures map[string]time.Time
+}
+
+// New creates a new multi builder bid provider.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "builderbidprovider").Str("impl", "multi").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	metrics, err := registerMetrics(ctx, parameters.monitor)
+	if err != nil {
+		return nil, errors.New("failed to register metrics")
+	}
+
+	builderDomain, err := signing.ComputeBuilderDomain(parameters.genesisForkVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Service{
+		log:                 log,
+		metrics:             metrics,
+		timeout:             parameters.timeout,
+		builderDomain:       builderDomain,
+		builderBidProviders: parameters.builderBidProviders,
+		bidTraceRecorder:    parameters.bidTraceRecorder,
+		healthRecovery:      parameters.healthRecovery,
+		// Providers are assumed to be healthy until they fail to respond.
+		providerFailures: make(map[string]time.Time, len(parameters.builderBidProviders)),
+	}
+
+	return s, nil
+}
@@ -0,0 +1,100 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-block-relay/services/builderbidprovider/multi"
+	"github.com/attestantio/go-block-relay/testing/builder"
+	builderclient "github.com/attestantio/go-builder-client"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	provider := builder.NewProvider(t, "upstream", 0x01, 1)
+
+	tests := []struct {
+		name   string
+		params []multi.Parameter
+		err    string
+	}{
+		{
+			name: "MonitorMissing",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithMonitor(nil),
+				multi.WithBuilderBidProviders([]builderclient.BuilderBidProvider{provider}),
+			},
+			err: "problem with parameters: no monitor specified",
+		},
+		{
+			name: "TimeoutZero",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithTimeout(0),
+				multi.WithBuilderBidProviders([]builderclient.BuilderBidProvider{provider}),
+			},
+			err: "problem with parameters: timeout must be greater than 0",
+		},
+		{
+			name: "HealthRecoveryZero",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithHealthRecovery(0),
+				multi.WithBuilderBidProviders([]builderclient.BuilderBidProvider{provider}),
+			},
+			err: "problem with parameters: health recovery must be greater than 0",
+		},
+		{
+			name: "BuilderBidProvidersMissing",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no builder bid providers specified",
+		},
+		{
+			name: "BuilderBidProviderNil",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithBuilderBidProviders([]builderclient.BuilderBidProvider{provider, nil}),
+			},
+			err: "problem with parameters: nil builder bid provider specified",
+		},
+		{
+			name: "Good",
+			params: []multi.Parameter{
+				multi.WithLogLevel(zerolog.Disabled),
+				multi.WithTimeout(time.Second),
+				multi.WithBuilderBidProviders([]builderclient.BuilderBidProvider{provider}),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := multi.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
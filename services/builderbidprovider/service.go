@@ -1,4 +1,4 @@
-// Copyright © 2022 Attestant Limited.
+// Copyright © 2022, 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -16,6 +16,7 @@ package builderbidprovider
 import (
 	"context"
 
+	builderapi "github.com/attestantio/go-builder-client/api"
 	"github.com/attestantio/go-builder-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
@@ -32,3 +33,37 @@ type Service interface {
 		error,
 	)
 }
+
+// ExecutionPayloadProvider is the interface for providers that can supply the
+// execution payload and blobs bundle behind the bids they provide.
+type ExecutionPayloadProvider interface {
+	// ExecutionPayload provides the execution payload and blobs bundle for the bid with the given block hash.
+	// It returns nil if the payload is not known.
+	ExecutionPayload(ctx context.Context,
+		slot phase0.Slot,
+		blockHash phase0.Hash32,
+	) (
+		*builderapi.VersionedSubmitBlindedBlockResponse,
+		error,
+	)
+}
+
+// BidBuilderProvider is the interface for providers that can supply the builder
+// behind the bids they provide, where that differs from the signer of the bid.
+type BidBuilderProvider interface {
+	// BidBuilder provides the public key of the builder of the bid with the given block hash.
+	// It returns nil if the builder is not known.
+	BidBuilder(ctx context.Context,
+		slot phase0.Slot,
+		blockHash phase0.Hash32,
+	) (
+		*phase0.BLSPubKey,
+		error,
+	)
+}
+
+// HealthReporter is the interface for builder bid providers that can report their health.
+type HealthReporter interface {
+	// Healthy returns true if the service is able to handle requests.
+	Healthy(ctx context.Context) bool
+}
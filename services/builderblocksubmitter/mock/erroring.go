@@ -0,0 +1,36 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"errors"
+
+	"github.com/attestantio/go-builder-client/spec"
+)
+
+// ErroringService is a mock builder block submitter.
+type ErroringService struct{}
+
+// NewErroring creates a new mock builder block submitter.
+func NewErroring() *ErroringService {
+	return &ErroringService{}
+}
+
+// SubmitBlock submits a block from a builder.
+func (s *ErroringService) SubmitBlock(_ context.Context,
+	_ *spec.VersionedSubmitBlockRequest,
+) error {
+	return errors.New("error")
+}
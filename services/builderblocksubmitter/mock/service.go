@@ -0,0 +1,35 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-builder-client/spec"
+)
+
+// Service is a mock builder block submitter.
+type Service struct{}
+
+// New creates a new mock builder block submitter.
+func New() *Service {
+	return &Service{}
+}
+
+// SubmitBlock submits a block from a builder.
+func (s *Service) SubmitBlock(_ context.Context,
+	_ *spec.VersionedSubmitBlockRequest,
+) error {
+	return nil
+}
@@ -0,0 +1,269 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"fmt"
+
+	"github.com/attestantio/go-block-relay/signing"
+	builderapi "github.com/attestantio/go-builder-client/api"
+	builderapideneb "github.com/attestantio/go-builder-client/api/deneb"
+	builderapielectra "github.com/attestantio/go-builder-client/api/electra"
+	builderapifulu "github.com/attestantio/go-builder-client/api/fulu"
+	"github.com/attestantio/go-builder-client/spec"
+	consensusspec "github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	ssz "github.com/ferranbt/fastssz"
+	"github.com/pkg/errors"
+)
+
+// builderBid creates the builder bid and payload for a block submission.
+// The bid carries the builder's public key and is unsigned.
+// Only versions from Deneb onwards are supported, as earlier payloads cannot be unblinded.
+func builderBid(request *spec.VersionedSubmitBlockRequest) (
+	*spec.VersionedSignedBuilderBid,
+	*builderapi.VersionedSubmitBlindedBlockResponse,
+	error,
+) {
+	bid := &spec.VersionedSignedBuilderBid{
+		Version: request.Version,
+	}
+	payload := &builderapi.VersionedSubmitBlindedBlockResponse{
+		Version: request.Version,
+	}
+
+	switch request.Version {
+	case consensusspec.DataVersionDeneb:
+		if request.Deneb.ExecutionPayload == nil {
+			return nil, nil, errors.New("no execution payload")
+		}
+		if request.Deneb.BlobsBundle == nil {
+			return nil, nil, errors.New("no blobs bundle")
+		}
+		header, err := denebHeader(request.Deneb.ExecutionPayload)
+		if err != nil {
+			return nil, nil, err
+		}
+		bid.Deneb = &builderapideneb.SignedBuilderBid{
+			Message: &builderapideneb.BuilderBid{
+				Header:             header,
+				BlobKZGCommitments: request.Deneb.BlobsBundle.Commitments,
+				Value:              request.Deneb.Message.Value,
+				Pubkey:             request.Deneb.Message.BuilderPubkey,
+			},
+		}
+		payload.Deneb = &builderapideneb.ExecutionPayloadAndBlobsBundle{
+			ExecutionPayload: request.Deneb.ExecutionPayload,
+			BlobsBundle:      request.Deneb.BlobsBundle,
+		}
+	case consensusspec.DataVersionElectra:
+		if request.Electra.ExecutionPayload == nil {
+			return nil, nil, errors.New("no execution payload")
+		}
+		if request.Electra.BlobsBundle == nil {
+			return nil, nil, errors.New("no blobs bundle")
+		}
+		if request.Electra.ExecutionRequests == nil {
+			return nil, nil, errors.New("no execution requests")
+		}
+		header, err := denebHeader(request.Electra.ExecutionPayload)
+		if err != nil {
+			return nil, nil, err
+		}
+		bid.Electra = &builderapielectra.SignedBuilderBid{
+			Message: &builderapielectra.BuilderBid{
+				Header:             header,
+				BlobKZGCommitments: request.Electra.BlobsBundle.Commitments,
+				ExecutionRequests:  request.Electra.ExecutionRequests,
+				Value:              request.Electra.Message.Value,
+				Pubkey:             request.Electra.Message.BuilderPubkey,
+			},
+		}
+		payload.Electra = &builderapideneb.ExecutionPayloadAndBlobsBundle{
+			ExecutionPayload: request.Electra.ExecutionPayload,
+			BlobsBundle:      request.Electra.BlobsBundle,
+		}
+	case consensusspec.DataVersionFulu:
+		if request.Fulu.ExecutionPayload == nil {
+			return nil, nil, errors.New("no execution payload")
+		}
+		if request.Fulu.BlobsBundle == nil {
+			return nil, nil, errors.New("no blobs bundle")
+		}
+		if request.Fulu.ExecutionRequests == nil {
+			return nil, nil, errors.New("no execution requests")
+		}
+		header, err := denebHeader(request.Fulu.ExecutionPayload)
+		if err != nil {
+			return nil, nil, err
+		}
+		bid.Fulu = &builderapielectra.SignedBuilderBid{
+			Message: &builderapielectra.BuilderBid{
+				Header:             header,
+				BlobKZGCommitments: request.Fulu.BlobsBundle.Commitments,
+				ExecutionRequests:  request.Fulu.ExecutionRequests,
+				Value:              request.Fulu.Message.Value,
+				Pubkey:             request.Fulu.Message.BuilderPubkey,
+			},
+		}
+		payload.Fulu = &builderapifulu.ExecutionPayloadAndBlobsBundle{
+			ExecutionPayload: request.Fulu.ExecutionPayload,
+			BlobsBundle:      request.Fulu.BlobsBundle,
+		}
+	default:
+		return nil, nil, fmt.Errorf("unsupported submission version %v", request.Version)
+	}
+
+	return bid, payload, nil
+}
+
+// signBid creates a copy of the bid that carries the relay's public key and is
+// signed by the relay in the builder domain.
+func (s *Service) signBid(bid *spec.VersionedSignedBuilderBid) (*spec.VersionedSignedBuilderBid, error) {
+	signed := &spec.VersionedSignedBuilderBid{
+		Version: bid.Version,
+	}
+
+	var root phase0.Root
+
+	var err error
+
+	switch bid.Version {
+	case consensusspec.DataVersionDeneb:
+		message := *bid.Deneb.Message
+		message.Pubkey = s.pubkey
+		signed.Deneb = &builderapideneb.SignedBuilderBid{Message: &message}
+		root, err = message.HashTreeRoot()
+	case consensusspec.DataVersionElectra:
+		message := *bid.Electra.Message
+		message.Pubkey = s.pubkey
+		signed.Electra = &builderapielectra.SignedBuilderBid{Message: &message}
+		root, err = message.HashTreeRoot()
+	case consensusspec.DataVersionFulu:
+		message := *bid.Fulu.Message
+		message.Pubkey = s.pubkey
+		signed.Fulu = &builderapielectra.SignedBuilderBid{Message: &message}
+		root, err = message.HashTreeRoot()
+	default:
+		return nil, fmt.Errorf("unsupported bid version %v", bid.Version)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	signingRoot, err := signing.ComputeSigningRoot(root, s.builderDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := signing.Sign(s.secretKey, signingRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	switch signed.Version {
+	case consensusspec.DataVersionDeneb:
+		signed.Deneb.Signature = signature
+	case consensusspec.DataVersionElectra:
+		signed.Electra.Signature = signature
+	case consensusspec.DataVersionFulu:
+		signed.Fulu.Signature = signature
+	}
+
+	return signed, nil
+}
+
+// denebHeader creates the execution payload header for a deneb payload.
+func denebHeader(payload *deneb.ExecutionPayload) (*deneb.ExecutionPayloadHeader, error) {
+	if payload.BaseFeePerGas == nil {
+		return nil, errors.New("execution payload has no base fee per gas")
+	}
+
+	transactionsRoot, err := transactionsRoot(payload.Transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawalsRoot, err := withdrawalsRoot(payload.Withdrawals)
+	if err != nil {
+		return nil, err
+	}
+
+	return &deneb.ExecutionPayloadHeader{
+		ParentHash:       payload.ParentHash,
+		FeeRecipient:     payload.FeeRecipient,
+		StateRoot:        payload.StateRoot,
+		ReceiptsRoot:     payload.ReceiptsRoot,
+		LogsBloom:        payload.LogsBloom,
+		PrevRandao:       payload.PrevRandao,
+		BlockNumber:      payload.BlockNumber,
+		GasLimit:         payload.GasLimit,
+		GasUsed:          payload.GasUsed,
+		Timestamp:        payload.Timestamp,
+		ExtraData:        payload.ExtraData,
+		BaseFeePerGas:    payload.BaseFeePerGas,
+		BlockHash:        payload.BlockHash,
+		TransactionsRoot: transactionsRoot,
+		WithdrawalsRoot:  withdrawalsRoot,
+		BlobGasUsed:      payload.BlobGasUsed,
+		ExcessBlobGas:    payload.ExcessBlobGas,
+	}, nil
+}
+
+// transactionsRoot calculates the hash tree root of a payload's transactions,
+// using the limits of the execution payload container.
+func transactionsRoot(transactions []bellatrix.Transaction) (phase0.Root, error) {
+	hh := ssz.NewHasher()
+	index := hh.Index()
+	for _, tx := range transactions {
+		txIndex := hh.Index()
+		hh.AppendBytes32(tx)
+		hh.MerkleizeWithMixin(txIndex, uint64(len(tx)), (1073741824+31)/32)
+	}
+	hh.MerkleizeWithMixin(index, uint64(len(transactions)), 1048576)
+
+	root, err := hh.HashRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to calculate transactions root")
+	}
+
+	return root, nil
+}
+
+// withdrawalsRoot calculates the hash tree root of a payload's withdrawals,
+// using the limits of the execution payload container.
+func withdrawalsRoot(withdrawals []*capella.Withdrawal) (phase0.Root, error) {
+	hh := ssz.NewHasher()
+	index := hh.Index()
+	for _, withdrawal := range withdrawals {
+		if withdrawal == nil {
+			return phase0.Root{}, errors.New("nil withdrawal")
+		}
+		err := withdrawal.HashTreeRootWith(hh)
+		if err != nil {
+			return phase0.Root{}, errors.Wrap(err, "failed to calculate withdrawal root")
+		}
+	}
+	hh.MerkleizeWithMixin(index, uint64(len(withdrawals)), 16)
+
+	root, err := hh.HashRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to calculate withdrawals root")
+	}
+
+	return root, nil
+}
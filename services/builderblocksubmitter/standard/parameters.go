@@ -0,0 +1,171 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"errors"
+	"time"
+
+	"github.com/attestantio/go-block-relay/services/bidtracerecorder"
+	"github.com/attestantio/go-block-relay/services/gaslimitprovider"
+	"github.com/attestantio/go-block-relay/services/validatorregistrar"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel                      zerolog.Level
+	genesisForkVersion            phase0.Version
+	genesisTime                   time.Time
+	slotDuration                  time.Duration
+	secretKey                     []byte
+	retentionSlots                uint64
+	maxSubmissionsPerSlot         int
+	validatorRegistrationProvider validatorregistrar.ValidatorRegistrationProvider
+	parentGasLimitProvider        gaslimitprovider.Service
+	bidTraceRecorder              bidtracerecorder.ReceivedBidRecorder
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithGenesisForkVersion sets the genesis fork version of the chain, used to verify
+// submission signatures and to sign the bids served to proposers.
+func WithGenesisForkVersion(version phase0.Version) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisForkVersion = version
+	})
+}
+
+// WithGenesisTime sets the genesis time of the chain, used to obtain the current slot.
+func WithGenesisTime(genesisTime time.Time) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisTime = genesisTime
+	})
+}
+
+// WithSlotDuration sets the duration of a slot of the chain, used to obtain the current slot.
+func WithSlotDuration(duration time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.slotDuration = duration
+	})
+}
+
+// WithSecretKey sets the secret key of the relay, used to sign the bids served to proposers.
+// The secret key must be the 32-byte big-endian representation of the key.
+func WithSecretKey(secretKey []byte) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.secretKey = secretKey
+	})
+}
+
+// WithRetentionSlots sets the number of slots for which submitted bids are retained.
+func WithRetentionSlots(slots uint64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.retentionSlots = slots
+	})
+}
+
+// WithMaxSubmissionsPerSlot sets the maximum number of submissions stored for a slot.
+// Submissions beyond the maximum are rejected.
+func WithMaxSubmissionsPerSlot(submissions int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxSubmissionsPerSlot = submissions
+	})
+}
+
+// WithValidatorRegistrationProvider sets the provider of validator registrations
+// against which submissions are checked.
+func WithValidatorRegistrationProvider(provider validatorregistrar.ValidatorRegistrationProvider) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.validatorRegistrationProvider = provider
+	})
+}
+
+// WithParentGasLimitProvider sets the provider of the gas limits of parent blocks,
+// from which the expected gas limits of submissions are calculated.
+func WithParentGasLimitProvider(provider gaslimitprovider.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.parentGasLimitProvider = provider
+	})
+}
+
+// WithBidTraceRecorder sets the recorder for bids received from builders.
+// If not supplied received bids are not recorded.
+func WithBidTraceRecorder(recorder bidtracerecorder.ReceivedBidRecorder) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.bidTraceRecorder = recorder
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:              zerolog.GlobalLevel(),
+		slotDuration:          12 * time.Second,
+		retentionSlots:        64,
+		maxSubmissionsPerSlot: 500,
+	}
+
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if len(parameters.secretKey) == 0 {
+		return nil, errors.New("no secret key specified")
+	}
+
+	if parameters.genesisTime.IsZero() {
+		return nil, errors.New("no genesis time specified")
+	}
+
+	if parameters.slotDuration <= 0 {
+		return nil, errors.New("slot duration must be greater than 0")
+	}
+
+	if parameters.retentionSlots == 0 {
+		return nil, errors.New("retention slots must be greater than 0")
+	}
+
+	if parameters.maxSubmissionsPerSlot <= 0 {
+		return nil, errors.New("max submissions per slot must be greater than 0")
+	}
+
+	if parameters.validatorRegistrationProvider == nil {
+		return nil, errors.New("no validator registration provider specified")
+	}
+
+	if parameters.parentGasLimitProvider == nil {
+		return nil, errors.New("no parent gas limit provider specified")
+	}
+
+	return &parameters, nil
+}
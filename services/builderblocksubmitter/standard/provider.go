@@ -0,0 +1,89 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+
+	builderapi "github.com/attestantio/go-builder-client/api"
+	"github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// BuilderBid provides the best bid submitted for the given slot, parent hash and proposer.
+// If no bid has been submitted this returns nil.
+func (s *Service) BuilderBid(_ context.Context,
+	slot phase0.Slot,
+	parentHash phase0.Hash32,
+	pubkey phase0.BLSPubKey,
+) (
+	*spec.VersionedSignedBuilderBid,
+	error,
+) {
+	key := bidKey{
+		slot:           slot,
+		parentHash:     parentHash,
+		proposerPubkey: pubkey,
+	}
+
+	s.submissionsMu.RLock()
+	best, exists := s.bestBids[key]
+	s.submissionsMu.RUnlock()
+	if !exists {
+		return nil, nil
+	}
+
+	return best.bid, nil
+}
+
+// ExecutionPayload provides the execution payload and blobs bundle for the bid with the given block hash.
+// It returns nil if the payload is not known.
+func (s *Service) ExecutionPayload(_ context.Context,
+	slot phase0.Slot,
+	blockHash phase0.Hash32,
+) (
+	*builderapi.VersionedSubmitBlindedBlockResponse,
+	error,
+) {
+	s.submissionsMu.RLock()
+	sub, exists := s.payloads[blockHash]
+	s.submissionsMu.RUnlock()
+	if !exists || sub.slot != slot {
+		return nil, nil
+	}
+
+	return sub.payload, nil
+}
+
+// BidBuilder provides the public key of the builder that submitted the bid with the given block hash.
+// Bids are signed by the relay, so this is not available from the bid itself.
+// It returns nil if the bid is not known.
+func (s *Service) BidBuilder(_ context.Context,
+	slot phase0.Slot,
+	blockHash phase0.Hash32,
+) (
+	*phase0.BLSPubKey,
+	error,
+) {
+	s.submissionsMu.RLock()
+	sub, exists := s.payloads[blockHash]
+	s.submissionsMu.RUnlock()
+	if !exists || sub.slot != slot {
+		return nil, nil
+	}
+
+	builder := sub.builder
+
+	return &builder, nil
+}
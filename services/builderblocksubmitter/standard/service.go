@@ -0,0 +1,123 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-block-relay/services/bidtracerecorder"
+	"github.com/attestantio/go-block-relay/services/gaslimitprovider"
+	"github.com/attestantio/go-block-relay/services/validatorregistrar"
+	"github.com/attestantio/go-block-relay/signing"
+	builderapi "github.com/attestantio/go-builder-client/api"
+	"github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// bidKey identifies the auction to which a bid belongs.
+type bidKey struct {
+	slot           phase0.Slot
+	parentHash     phase0.Hash32
+	proposerPubkey phase0.BLSPubKey
+}
+
+// submission is an accepted block submission.
+type submission struct {
+	slot    phase0.Slot
+	value   *uint256.Int
+	builder phase0.BLSPubKey
+	bid     *spec.VersionedSignedBuilderBid
+	payload *builderapi.VersionedSubmitBlindedBlockResponse
+}
+
+// Service is a builder block submitter that holds the best submission for each
+// auction in memory, and provides it as a builder bid.
+type Service struct {
+	log                           zerolog.Logger
+	builderDomain                 phase0.Domain
+	genesisTime                   time.Time
+	slotDuration                  time.Duration
+	secretKey                     []byte
+	pubkey                        phase0.BLSPubKey
+	retentionSlots                uint64
+	maxSubmissionsPerSlot         int
+	validatorRegistrationProvider validatorregistrar.ValidatorRegistrationProvider
+	parentGasLimitProvider        gaslimitprovider.Service
+	bidTraceRecorder              bidtracerecorder.ReceivedBidRecorder
+
+	submissionsMu   sync.RWMutex
+	bestBids        map[bidKey]*submission
+	payloads        map[phase0.Hash32]*submission
+	slotSubmissions map[phase0.Slot]int
+	highestSlot     phase0.Slot
+}
+
+// New creates a new builder block submitter.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "builderblocksubmitter").Str("impl", "standard").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	builderDomain, err := signing.ComputeBuilderDomain(parameters.genesisForkVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	pubkey, err := signing.PublicKey(parameters.secretKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain public key")
+	}
+
+	s := &Service{
+		log:                           log,
+		builderDomain:                 builderDomain,
+		genesisTime:                   parameters.genesisTime,
+		slotDuration:                  parameters.slotDuration,
+		secretKey:                     parameters.secretKey,
+		pubkey:                        pubkey,
+		retentionSlots:                parameters.retentionSlots,
+		maxSubmissionsPerSlot:         parameters.maxSubmissionsPerSlot,
+		validatorRegistrationProvider: parameters.validatorRegistrationProvider,
+		parentGasLimitProvider:        parameters.parentGasLimitProvider,
+		bidTraceRecorder:              parameters.bidTraceRecorder,
+		bestBids:                      make(map[bidKey]*submission),
+		payloads:                      make(map[phase0.Hash32]*submission),
+		slotSubmissions:               make(map[phase0.Slot]int),
+	}
+
+	return s, nil
+}
+
+// currentSlot provides the current slot of the chain.
+func (s *Service) currentSlot() phase0.Slot {
+	elapsed := time.Since(s.genesisTime)
+	if elapsed < 0 {
+		return 0
+	}
+
+	return phase0.Slot(elapsed / s.slotDuration)
+}
@@ -0,0 +1,147 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/attestantio/go-block-relay/services/builderblocksubmitter/standard"
+	mockgaslimitprovider "github.com/attestantio/go-block-relay/services/gaslimitprovider/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	registrations := &registrationProvider{}
+	gasLimits := mockgaslimitprovider.New(30000000)
+	genesisTime := testGenesisTime()
+
+	tests := []struct {
+		name   string
+		params []standard.Parameter
+		err    string
+	}{
+		{
+			name: "SecretKeyMissing",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithGenesisTime(genesisTime),
+				standard.WithValidatorRegistrationProvider(registrations),
+				standard.WithParentGasLimitProvider(gasLimits),
+			},
+			err: "problem with parameters: no secret key specified",
+		},
+		{
+			name: "SecretKeyInvalid",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithGenesisTime(genesisTime),
+				standard.WithSecretKey([]byte{0x01}),
+				standard.WithValidatorRegistrationProvider(registrations),
+				standard.WithParentGasLimitProvider(gasLimits),
+			},
+			err: "failed to obtain public key: invalid secret key",
+		},
+		{
+			name: "GenesisTimeMissing",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithSecretKey(secretKey(relayKey)),
+				standard.WithValidatorRegistrationProvider(registrations),
+				standard.WithParentGasLimitProvider(gasLimits),
+			},
+			err: "problem with parameters: no genesis time specified",
+		},
+		{
+			name: "SlotDurationZero",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithGenesisTime(genesisTime),
+				standard.WithSlotDuration(0),
+				standard.WithSecretKey(secretKey(relayKey)),
+				standard.WithValidatorRegistrationProvider(registrations),
+				standard.WithParentGasLimitProvider(gasLimits),
+			},
+			err: "problem with parameters: slot duration must be greater than 0",
+		},
+		{
+			name: "RetentionSlotsZero",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithGenesisTime(genesisTime),
+				standard.WithSecretKey(secretKey(relayKey)),
+				standard.WithRetentionSlots(0),
+				standard.WithValidatorRegistrationProvider(registrations),
+				standard.WithParentGasLimitProvider(gasLimits),
+			},
+			err: "problem with parameters: retention slots must be greater than 0",
+		},
+		{
+			name: "MaxSubmissionsPerSlotZero",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithGenesisTime(genesisTime),
+				standard.WithSecretKey(secretKey(relayKey)),
+				standard.WithMaxSubmissionsPerSlot(0),
+				standard.WithValidatorRegistrationProvider(registrations),
+				standard.WithParentGasLimitProvider(gasLimits),
+			},
+			err: "problem with parameters: max submissions per slot must be greater than 0",
+		},
+		{
+			name: "ValidatorRegistrationProviderMissing",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithGenesisTime(genesisTime),
+				standard.WithSecretKey(secretKey(relayKey)),
+			},
+			err: "problem with parameters: no validator registration provider specified",
+		},
+		{
+			name: "ParentGasLimitProviderMissing",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithGenesisTime(genesisTime),
+				standard.WithSecretKey(secretKey(relayKey)),
+				standard.WithValidatorRegistrationProvider(registrations),
+			},
+			err: "problem with parameters: no parent gas limit provider specified",
+		},
+		{
+			name: "Good",
+			params: []standard.Parameter{
+				standard.WithLogLevel(zerolog.Disabled),
+				standard.WithGenesisTime(genesisTime),
+				standard.WithSecretKey(secretKey(relayKey)),
+				standard.WithRetentionSlots(32),
+				standard.WithValidatorRegistrationProvider(registrations),
+				standard.WithParentGasLimitProvider(gasLimits),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := standard.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,361 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	relay "github.com/attestantio/go-block-relay"
+	"github.com/attestantio/go-block-relay/loggers"
+	"github.com/attestantio/go-block-relay/signing"
+	apiv1 "github.com/attestantio/go-builder-client/api/v1"
+	"github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SubmitBlock submits a block from a builder.
+// Submissions that are invalid return an error wrapping relay.ErrInvalidOptions.
+func (s *Service) SubmitBlock(ctx context.Context,
+	request *spec.VersionedSubmitBlockRequest,
+) error {
+	ctx, span := otel.Tracer("attestantio.go-block-relay.services.builderblocksubmitter.standard").Start(ctx, "SubmitBlock")
+	defer span.End()
+
+	received := time.Now()
+
+	err := s.submitBlock(ctx, request, received)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (s *Service) submitBlock(ctx context.Context,
+	request *spec.VersionedSubmitBlockRequest,
+	received time.Time,
+) error {
+	log := loggers.WithRequestID(ctx, s.log)
+
+	if request == nil {
+		return errors.Wrap(relay.ErrInvalidOptions, "no request supplied")
+	}
+
+	if request.IsEmpty() {
+		return errors.Wrap(relay.ErrInvalidOptions, "request is empty")
+	}
+
+	bidTrace, err := request.BidTrace()
+	if err != nil {
+		return errors.Wrap(relay.ErrInvalidOptions, err.Error())
+	}
+
+	if bidTrace.Value == nil {
+		return errors.Wrap(relay.ErrInvalidOptions, "bid trace has no value")
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(
+		attribute.Int64("slot", int64(bidTrace.Slot)),
+		attribute.String("block_hash", bidTrace.BlockHash.String()),
+		attribute.String("builder_pubkey", bidTrace.BuilderPubkey.String()),
+	)
+
+	// Only auctions that can still be won are accepted, which also bounds the slots held in memory.
+	currentSlot := s.currentSlot()
+	if bidTrace.Slot != uint64(currentSlot) && bidTrace.Slot != uint64(currentSlot)+1 {
+		return errors.Wrap(relay.ErrInvalidOptions,
+			fmt.Sprintf("slot %d is neither the current slot %d nor the next slot", bidTrace.Slot, currentSlot),
+		)
+	}
+
+	// Build the bid first, as this confirms the request is complete.
+	bid, payload, err := builderBid(request)
+	if err != nil {
+		return errors.Wrap(relay.ErrInvalidOptions, err.Error())
+	}
+
+	err = checkBidTrace(bidTrace, request)
+	if err != nil {
+		return errors.Wrap(relay.ErrInvalidOptions, err.Error())
+	}
+
+	err = s.verifySignature(request, bidTrace)
+	if err != nil {
+		return errors.Wrap(relay.ErrInvalidOptions, err.Error())
+	}
+
+	err = s.checkRegistration(ctx, bidTrace)
+	if err != nil {
+		return err
+	}
+
+	slot := phase0.Slot(bidTrace.Slot)
+
+	// The recorded bid is the builder's, before it is signed by the relay.
+	s.recordReceivedBid(ctx, slot, bidTrace.ProposerPubkey, bid, received)
+
+	bid, err = s.signBid(bid)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign bid")
+	}
+
+	best, err := s.storeSubmission(ctx, bidTrace, &submission{
+		slot:    slot,
+		value:   bidTrace.Value,
+		builder: bidTrace.BuilderPubkey,
+		bid:     bid,
+		payload: payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Trace().
+		Uint64("slot", bidTrace.Slot).
+		Stringer("block_hash", bidTrace.BlockHash).
+		Stringer("value", bidTrace.Value).
+		Bool("best", best).
+		Msg("Accepted block submission")
+
+	return nil
+}
+
+// checkBidTrace confirms that the bid trace matches the execution payload.
+func checkBidTrace(bidTrace *apiv1.BidTrace,
+	request *spec.VersionedSubmitBlockRequest,
+) error {
+	blockHash, err := request.ExecutionPayloadBlockHash()
+	if err != nil {
+		return err
+	}
+	if blockHash != bidTrace.BlockHash {
+		return fmt.Errorf("bid trace block hash %#x does not match payload block hash %#x", bidTrace.BlockHash, blockHash)
+	}
+
+	parentHash, err := request.ExecutionPayloadParentHash()
+	if err != nil {
+		return err
+	}
+	if parentHash != bidTrace.ParentHash {
+		return fmt.Errorf("bid trace parent hash %#x does not match payload parent hash %#x", bidTrace.ParentHash, parentHash)
+	}
+
+	gasLimit, err := request.GasLimit()
+	if err != nil {
+		return err
+	}
+	if gasLimit != bidTrace.GasLimit {
+		return fmt.Errorf("bid trace gas limit %d does not match payload gas limit %d", bidTrace.GasLimit, gasLimit)
+	}
+
+	gasUsed, err := request.GasUsed()
+	if err != nil {
+		return err
+	}
+	if gasUsed != bidTrace.GasUsed {
+		return fmt.Errorf("bid trace gas used %d does not match payload gas used %d", bidTrace.GasUsed, gasUsed)
+	}
+
+	return nil
+}
+
+// verifySignature verifies the builder's signature of the bid trace in the builder domain.
+func (s *Service) verifySignature(request *spec.VersionedSubmitBlockRequest,
+	bidTrace *apiv1.BidTrace,
+) error {
+	signature, err := request.Signature()
+	if err != nil {
+		return err
+	}
+
+	root, err := bidTrace.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate bid trace root")
+	}
+
+	signingRoot, err := signing.ComputeSigningRoot(root, s.builderDomain)
+	if err != nil {
+		return err
+	}
+
+	verified, err := signing.Verify(bidTrace.BuilderPubkey, signingRoot, signature)
+	if err != nil {
+		return err
+	}
+	if !verified {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
+
+// checkRegistration confirms that the bid pays the proposer's registered fee recipient,
+// and moves the gas limit from that of the parent block toward the proposer's registered gas limit.
+func (s *Service) checkRegistration(ctx context.Context,
+	bidTrace *apiv1.BidTrace,
+) error {
+	registration, err := s.validatorRegistrationProvider.ValidatorRegistration(ctx, bidTrace.ProposerPubkey)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain validator registration")
+	}
+
+	if registration == nil || registration.Message == nil {
+		return errors.Wrap(relay.ErrInvalidOptions, fmt.Sprintf("no registration for proposer %#x", bidTrace.ProposerPubkey))
+	}
+
+	if bidTrace.ProposerFeeRecipient != registration.Message.FeeRecipient {
+		return errors.Wrap(relay.ErrInvalidOptions,
+			fmt.Sprintf("fee recipient %#x does not match registered fee recipient %#x",
+				bidTrace.ProposerFeeRecipient, registration.Message.FeeRecipient),
+		)
+	}
+
+	parentGasLimit, err := s.parentGasLimitProvider.GasLimit(ctx, bidTrace.ParentHash)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain parent gas limit")
+	}
+
+	gasLimit := expectedGasLimit(parentGasLimit, registration.Message.GasLimit)
+	if bidTrace.GasLimit != gasLimit {
+		return errors.Wrap(relay.ErrInvalidOptions,
+			fmt.Sprintf("gas limit %d does not match expected gas limit %d for registered gas limit %d",
+				bidTrace.GasLimit, gasLimit, registration.Message.GasLimit),
+		)
+	}
+
+	return nil
+}
+
+// expectedGasLimit calculates the gas limit of a block given the gas limit of its
+// parent and the registered target.  The execution layer only allows the gas limit
+// to change by less than parent/1024 per block, so the gas limit moves toward the
+// target by as much as it can, and reaches it once it is within range.
+func expectedGasLimit(parentGasLimit uint64, targetGasLimit uint64) uint64 {
+	delta := parentGasLimit / 1024
+	if delta > 0 {
+		delta--
+	}
+
+	switch {
+	case targetGasLimit > parentGasLimit:
+		return min(parentGasLimit+delta, targetGasLimit)
+	case targetGasLimit < parentGasLimit:
+		return max(parentGasLimit-delta, targetGasLimit)
+	default:
+		return parentGasLimit
+	}
+}
+
+// recordReceivedBid records a valid bid with the bid trace recorder, if present.
+// Failure to record is not fatal, as it only affects the relay data API.
+func (s *Service) recordReceivedBid(ctx context.Context,
+	slot phase0.Slot,
+	proposerPubkey phase0.BLSPubKey,
+	bid *spec.VersionedSignedBuilderBid,
+	received time.Time,
+) {
+	if s.bidTraceRecorder == nil {
+		return
+	}
+
+	// Submissions are fully verified before they are accepted, so are never optimistic.
+	err := s.bidTraceRecorder.RecordReceivedBid(ctx, slot, proposerPubkey, bid, received, false)
+	if err != nil {
+		log := loggers.WithRequestID(ctx, s.log)
+		log.Warn().Err(err).Uint64("slot", uint64(slot)).Msg("Failed to record received bid")
+	}
+}
+
+// storeSubmission stores a submission, making it the best bid for its auction if
+// its value is higher than that of the current best bid.
+// It returns true if the submission is the new best bid, and an error if the slot
+// already holds the maximum number of submissions.
+func (s *Service) storeSubmission(ctx context.Context,
+	bidTrace *apiv1.BidTrace,
+	sub *submission,
+) (
+	bool,
+	error,
+) {
+	key := bidKey{
+		slot:           sub.slot,
+		parentHash:     bidTrace.ParentHash,
+		proposerPubkey: bidTrace.ProposerPubkey,
+	}
+
+	s.submissionsMu.Lock()
+	defer s.submissionsMu.Unlock()
+
+	current, exists := s.bestBids[key]
+	if exists && current.value.Cmp(sub.value) >= 0 {
+		return false, nil
+	}
+
+	// Payloads of superseded bids are retained, as they may already have been served,
+	// so the number held for each slot is capped.
+	if s.slotSubmissions[sub.slot] >= s.maxSubmissionsPerSlot {
+		return false, errors.Wrap(relay.ErrInvalidOptions, fmt.Sprintf("too many submissions for slot %d", sub.slot))
+	}
+
+	s.bestBids[key] = sub
+	if _, exists := s.payloads[bidTrace.BlockHash]; !exists {
+		s.slotSubmissions[sub.slot]++
+	}
+	s.payloads[bidTrace.BlockHash] = sub
+	if sub.slot > s.highestSlot {
+		s.highestSlot = sub.slot
+		s.prune(ctx)
+	}
+
+	return true, nil
+}
+
+// prune removes submissions that are outside of the retention period.
+// This must be called with the submissions lock held.
+func (s *Service) prune(ctx context.Context) {
+	if uint64(s.highestSlot) < s.retentionSlots {
+		return
+	}
+
+	minSlot := s.highestSlot - phase0.Slot(s.retentionSlots)
+	pruned := 0
+	for key := range s.bestBids {
+		if key.slot < minSlot {
+			delete(s.bestBids, key)
+		}
+	}
+	for blockHash, sub := range s.payloads {
+		if sub.slot < minSlot {
+			delete(s.payloads, blockHash)
+			pruned++
+		}
+	}
+	for slot := range s.slotSubmissions {
+		if slot < minSlot {
+			delete(s.slotSubmissions, slot)
+		}
+	}
+
+	if pruned > 0 {
+		log := loggers.WithRequestID(ctx, s.log)
+		log.Trace().Uint64("min_slot", uint64(minSlot)).Int("pruned", pruned).Msg("Pruned submissions")
+	}
+}
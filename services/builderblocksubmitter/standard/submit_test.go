@@ -0,0 +1,534 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	relay "github.com/attestantio/go-block-relay"
+	"github.com/attestantio/go-block-relay/services/bidtracerecorder"
+	standardbidtracerecorder "github.com/attestantio/go-block-relay/services/bidtracerecorder/standard"
+	"github.com/attestantio/go-block-relay/services/builderblocksubmitter/standard"
+	mockgaslimitprovider "github.com/attestantio/go-block-relay/services/gaslimitprovider/mock"
+	"github.com/attestantio/go-block-relay/signing"
+	"github.com/attestantio/go-block-relay/types"
+	builderapicapella "github.com/attestantio/go-builder-client/api/capella"
+	builderapideneb "github.com/attestantio/go-builder-client/api/deneb"
+	builderapielectra "github.com/attestantio/go-builder-client/api/electra"
+	apiv1 "github.com/attestantio/go-builder-client/api/v1"
+	"github.com/attestantio/go-builder-client/spec"
+	consensusspec "github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+const (
+	relayKey    = 0x01
+	builderKey  = 0x02
+	proposerKey = 0x03
+)
+
+var (
+	testParentHash   = phase0.Hash32{0x01}
+	testFeeRecipient = bellatrix.ExecutionAddress{0x02}
+)
+
+// secretKey provides a deterministic secret key for the given index.
+func secretKey(index byte) []byte {
+	return blst.KeyGen(bytes.Repeat([]byte{index}, 32)).Serialize()
+}
+
+// pubkey provides the public key for the given index.
+func pubkey(t *testing.T, index byte) phase0.BLSPubKey {
+	t.Helper()
+
+	pubkey, err := signing.PublicKey(secretKey(index))
+	require.NoError(t, err)
+
+	return pubkey
+}
+
+// registrationProvider provides registrations from a fixed set.
+type registrationProvider struct {
+	registrations map[phase0.BLSPubKey]*types.SignedValidatorRegistration
+}
+
+func (p *registrationProvider) ValidatorRegistration(_ context.Context,
+	pubkey phase0.BLSPubKey,
+) (
+	*types.SignedValidatorRegistration,
+	error,
+) {
+	return p.registrations[pubkey], nil
+}
+
+func (p *registrationProvider) AllValidatorRegistrations(_ context.Context) ([]*types.SignedValidatorRegistration, error) {
+	registrations := make([]*types.SignedValidatorRegistration, 0, len(p.registrations))
+	for _, registration := range p.registrations {
+		registrations = append(registrations, registration)
+	}
+
+	return registrations, nil
+}
+
+// testRegistrations provides a registration for the proposer.
+func testRegistrations(t *testing.T) *registrationProvider {
+	t.Helper()
+
+	return &registrationProvider{
+		registrations: map[phase0.BLSPubKey]*types.SignedValidatorRegistration{
+			pubkey(t, proposerKey): {
+				Message: &types.ValidatorRegistration{
+					FeeRecipient: testFeeRecipient,
+					GasLimit:     30000000,
+					Pubkey:       pubkey(t, proposerKey),
+				},
+			},
+		},
+	}
+}
+
+// testBidTrace creates a bid trace for a block with the given hash and value.
+func testBidTrace(t *testing.T, slot phase0.Slot, blockHash byte, value uint64) *apiv1.BidTrace {
+	t.Helper()
+
+	return &apiv1.BidTrace{
+		Slot:                 uint64(slot),
+		ParentHash:           testParentHash,
+		BlockHash:            phase0.Hash32{blockHash},
+		BuilderPubkey:        pubkey(t, builderKey),
+		ProposerPubkey:       pubkey(t, proposerKey),
+		ProposerFeeRecipient: testFeeRecipient,
+		GasLimit:             30000000,
+		GasUsed:              21000,
+		Value:                uint256.NewInt(value),
+	}
+}
+
+// testPayload creates an execution payload matching a bid trace.
+func testPayload(bidTrace *apiv1.BidTrace) *deneb.ExecutionPayload {
+	return &deneb.ExecutionPayload{
+		ParentHash:    bidTrace.ParentHash,
+		FeeRecipient:  bellatrix.ExecutionAddress{0x04},
+		BlockNumber:   5,
+		GasLimit:      bidTrace.GasLimit,
+		GasUsed:       bidTrace.GasUsed,
+		Timestamp:     1700000000,
+		BaseFeePerGas: uint256.NewInt(7),
+		BlockHash:     bidTrace.BlockHash,
+		Transactions:  []bellatrix.Transaction{{0x01, 0x02, 0x03}},
+		Withdrawals: []*capella.Withdrawal{
+			{Index: 1, ValidatorIndex: 2, Address: bellatrix.ExecutionAddress{0x03}, Amount: 4},
+		},
+	}
+}
+
+// sign signs a bid trace with the builder's key.
+func sign(t *testing.T, bidTrace *apiv1.BidTrace) phase0.BLSSignature {
+	t.Helper()
+
+	domain, err := signing.ComputeBuilderDomain(phase0.Version{})
+	require.NoError(t, err)
+	root, err := bidTrace.HashTreeRoot()
+	require.NoError(t, err)
+	signingRoot, err := signing.ComputeSigningRoot(root, domain)
+	require.NoError(t, err)
+	signature, err := signing.Sign(secretKey(builderKey), signingRoot)
+	require.NoError(t, err)
+
+	return signature
+}
+
+// testRequest creates a signed electra submission for a bid trace.
+func testRequest(t *testing.T, bidTrace *apiv1.BidTrace) *spec.VersionedSubmitBlockRequest {
+	t.Helper()
+
+	return &spec.VersionedSubmitBlockRequest{
+		Version: consensusspec.DataVersionElectra,
+		Electra: &builderapielectra.SubmitBlockRequest{
+			Message:          bidTrace,
+			ExecutionPayload: testPayload(bidTrace),
+			BlobsBundle: &builderapideneb.BlobsBundle{
+				Commitments: []deneb.KZGCommitment{{0x01}},
+				Proofs:      []deneb.KZGProof{{0x01}},
+				Blobs:       []deneb.Blob{{0x01}},
+			},
+			ExecutionRequests: &electra.ExecutionRequests{},
+			Signature:         sign(t, bidTrace),
+		},
+	}
+}
+
+// testGenesisTime provides a genesis time for which the current slot is 10.
+func testGenesisTime() time.Time {
+	return time.Now().Add(-10*12*time.Second - time.Second)
+}
+
+func newService(t *testing.T, params ...standard.Parameter) *standard.Service {
+	t.Helper()
+
+	params = append([]standard.Parameter{
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithGenesisTime(testGenesisTime()),
+		standard.WithSecretKey(secretKey(relayKey)),
+		standard.WithValidatorRegistrationProvider(testRegistrations(t)),
+		standard.WithParentGasLimitProvider(mockgaslimitprovider.New(30000000)),
+	}, params...)
+
+	s, err := standard.New(context.Background(), params...)
+	require.NoError(t, err)
+
+	return s
+}
+
+func TestSubmitBlock(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		parentGasLimit uint64
+		request        func(t *testing.T) *spec.VersionedSubmitBlockRequest
+		err            string
+	}{
+		{
+			name: "Nil",
+			request: func(_ *testing.T) *spec.VersionedSubmitBlockRequest {
+				return nil
+			},
+			err: "no request supplied: invalid options",
+		},
+		{
+			name: "Empty",
+			request: func(_ *testing.T) *spec.VersionedSubmitBlockRequest {
+				return &spec.VersionedSubmitBlockRequest{
+					Version: consensusspec.DataVersionElectra,
+				}
+			},
+			err: "request is empty: invalid options",
+		},
+		{
+			name: "PayloadMissing",
+			request: func(t *testing.T) *spec.VersionedSubmitBlockRequest {
+				request := testRequest(t, testBidTrace(t, 10, 0x10, 1))
+				request.Electra.ExecutionPayload = nil
+
+				return request
+			},
+			err: "no execution payload: invalid options",
+		},
+		{
+			name: "BlockHashMismatch",
+			request: func(t *testing.T) *spec.VersionedSubmitBlockRequest {
+				request := testRequest(t, testBidTrace(t, 10, 0x10, 1))
+				request.Electra.ExecutionPayload.BlockHash = phase0.Hash32{0x11}
+
+				return request
+			},
+			err: "bid trace block hash 0x1000000000000000000000000000000000000000000000000000000000000000 does not match payload block hash 0x1100000000000000000000000000000000000000000000000000000000000000: invalid options",
+		},
+		{
+			name: "SignatureInvalid",
+			request: func(t *testing.T) *spec.VersionedSubmitBlockRequest {
+				request := testRequest(t, testBidTrace(t, 10, 0x10, 1))
+				request.Electra.Message.Value = uint256.NewInt(2)
+
+				return request
+			},
+			err: "invalid signature: invalid options",
+		},
+		{
+			name: "Unregistered",
+			request: func(t *testing.T) *spec.VersionedSubmitBlockRequest {
+				bidTrace := testBidTrace(t, 10, 0x10, 1)
+				bidTrace.ProposerPubkey = pubkey(t, 0x04)
+
+				return testRequest(t, bidTrace)
+			},
+			err: "no registration for proposer 0x" + pubkey(t, 0x04).String()[2:] + ": invalid options",
+		},
+		{
+			name: "FeeRecipientMismatch",
+			request: func(t *testing.T) *spec.VersionedSubmitBlockRequest {
+				bidTrace := testBidTrace(t, 10, 0x10, 1)
+				bidTrace.ProposerFeeRecipient = bellatrix.ExecutionAddress{0x05}
+
+				return testRequest(t, bidTrace)
+			},
+			err: "fee recipient 0x0500000000000000000000000000000000000000 does not match registered fee recipient 0x0200000000000000000000000000000000000000: invalid options",
+		},
+		{
+			name: "GasLimitMismatch",
+			request: func(t *testing.T) *spec.VersionedSubmitBlockRequest {
+				bidTrace := testBidTrace(t, 10, 0x10, 1)
+				bidTrace.GasLimit = 36000000
+
+				return testRequest(t, bidTrace)
+			},
+			err: "gas limit 36000000 does not match expected gas limit 30000000 for registered gas limit 30000000: invalid options",
+		},
+		{
+			name:           "ParentBelowTarget",
+			parentGasLimit: 29000000,
+			request: func(t *testing.T) *spec.VersionedSubmitBlockRequest {
+				bidTrace := testBidTrace(t, 10, 0x10, 1)
+				bidTrace.GasLimit = 29028319
+
+				return testRequest(t, bidTrace)
+			},
+		},
+		{
+			name:           "ParentBelowTargetAtTarget",
+			parentGasLimit: 29000000,
+			request: func(t *testing.T) *spec.VersionedSubmitBlockRequest {
+				return testRequest(t, testBidTrace(t, 10, 0x10, 1))
+			},
+			err: "gas limit 30000000 does not match expected gas limit 29028319 for registered gas limit 30000000: invalid options",
+		},
+		{
+			name:           "ParentAboveTarget",
+			parentGasLimit: 31000000,
+			request: func(t *testing.T) *spec.VersionedSubmitBlockRequest {
+				bidTrace := testBidTrace(t, 10, 0x10, 1)
+				bidTrace.GasLimit = 30969728
+
+				return testRequest(t, bidTrace)
+			},
+		},
+		{
+			name:           "ParentNearTarget",
+			parentGasLimit: 29990000,
+			request: func(t *testing.T) *spec.VersionedSubmitBlockRequest {
+				return testRequest(t, testBidTrace(t, 10, 0x10, 1))
+			},
+		},
+		{
+			name: "SlotPast",
+			request: func(t *testing.T) *spec.VersionedSubmitBlockRequest {
+				return testRequest(t, testBidTrace(t, 9, 0x10, 1))
+			},
+			err: "slot 9 is neither the current slot 10 nor the next slot: invalid options",
+		},
+		{
+			name: "SlotFuture",
+			request: func(t *testing.T) *spec.VersionedSubmitBlockRequest {
+				return testRequest(t, testBidTrace(t, 12, 0x10, 1))
+			},
+			err: "slot 12 is neither the current slot 10 nor the next slot: invalid options",
+		},
+		{
+			name: "SlotNext",
+			request: func(t *testing.T) *spec.VersionedSubmitBlockRequest {
+				return testRequest(t, testBidTrace(t, 11, 0x10, 1))
+			},
+		},
+		{
+			name: "Good",
+			request: func(t *testing.T) *spec.VersionedSubmitBlockRequest {
+				return testRequest(t, testBidTrace(t, 10, 0x10, 1))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := make([]standard.Parameter, 0)
+			if test.parentGasLimit != 0 {
+				params = append(params, standard.WithParentGasLimitProvider(mockgaslimitprovider.New(test.parentGasLimit)))
+			}
+			s := newService(t, params...)
+			err := s.SubmitBlock(ctx, test.request(t))
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.ErrorIs(t, err, relay.ErrInvalidOptions)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSubmitBlockParentGasLimitErroring(t *testing.T) {
+	s := newService(t, standard.WithParentGasLimitProvider(mockgaslimitprovider.NewErroring()))
+	err := s.SubmitBlock(context.Background(), testRequest(t, testBidTrace(t, 10, 0x10, 1)))
+	require.EqualError(t, err, "failed to obtain parent gas limit: error")
+	require.NotErrorIs(t, err, relay.ErrInvalidOptions)
+}
+
+func TestSubmitBlockMaxSubmissionsPerSlot(t *testing.T) {
+	ctx := context.Background()
+
+	s := newService(t, standard.WithMaxSubmissionsPerSlot(2))
+
+	require.NoError(t, s.SubmitBlock(ctx, testRequest(t, testBidTrace(t, 10, 0x10, 1))))
+	require.NoError(t, s.SubmitBlock(ctx, testRequest(t, testBidTrace(t, 10, 0x11, 2))))
+
+	err := s.SubmitBlock(ctx, testRequest(t, testBidTrace(t, 10, 0x12, 3)))
+	require.EqualError(t, err, "too many submissions for slot 10: invalid options")
+	require.ErrorIs(t, err, relay.ErrInvalidOptions)
+
+	// Other slots are unaffected.
+	require.NoError(t, s.SubmitBlock(ctx, testRequest(t, testBidTrace(t, 11, 0x13, 1))))
+}
+
+func TestBuilderBid(t *testing.T) {
+	ctx := context.Background()
+
+	s := newService(t)
+
+	// No submissions.
+	bid, err := s.BuilderBid(ctx, 10, testParentHash, pubkey(t, proposerKey))
+	require.NoError(t, err)
+	require.Nil(t, bid)
+
+	require.NoError(t, s.SubmitBlock(ctx, testRequest(t, testBidTrace(t, 10, 0x10, 2))))
+	// Lower value does not replace the best bid.
+	require.NoError(t, s.SubmitBlock(ctx, testRequest(t, testBidTrace(t, 10, 0x11, 1))))
+
+	bid, err = s.BuilderBid(ctx, 10, testParentHash, pubkey(t, proposerKey))
+	require.NoError(t, err)
+	require.NotNil(t, bid)
+	blockHash, err := bid.BlockHash()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Hash32{0x10}, blockHash)
+
+	// Higher value replaces the best bid.
+	require.NoError(t, s.SubmitBlock(ctx, testRequest(t, testBidTrace(t, 10, 0x12, 3))))
+	bid, err = s.BuilderBid(ctx, 10, testParentHash, pubkey(t, proposerKey))
+	require.NoError(t, err)
+	blockHash, err = bid.BlockHash()
+	require.NoError(t, err)
+	require.Equal(t, phase0.Hash32{0x12}, blockHash)
+
+	// Bid is signed by the relay.
+	require.Equal(t, pubkey(t, relayKey), bid.Electra.Message.Pubkey)
+	domain, err := signing.ComputeBuilderDomain(phase0.Version{})
+	require.NoError(t, err)
+	root, err := bid.Electra.Message.HashTreeRoot()
+	require.NoError(t, err)
+	signingRoot, err := signing.ComputeSigningRoot(root, domain)
+	require.NoError(t, err)
+	verified, err := signing.Verify(pubkey(t, relayKey), signingRoot, bid.Electra.Signature)
+	require.NoError(t, err)
+	require.True(t, verified)
+
+	// Header summarises the payload.
+	payload, err := s.ExecutionPayload(ctx, 10, phase0.Hash32{0x12})
+	require.NoError(t, err)
+	require.NotNil(t, payload)
+	headerRoot, err := bid.Electra.Message.Header.HashTreeRoot()
+	require.NoError(t, err)
+	payloadRoot, err := payload.Electra.ExecutionPayload.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, payloadRoot, headerRoot)
+	require.Equal(t, payload.Electra.BlobsBundle.Commitments, bid.Electra.Message.BlobKZGCommitments)
+
+	// Superseded payloads remain available; payloads for other slots and rejected bids do not.
+	payload, err = s.ExecutionPayload(ctx, 10, phase0.Hash32{0x10})
+	require.NoError(t, err)
+	require.NotNil(t, payload)
+	payload, err = s.ExecutionPayload(ctx, 11, phase0.Hash32{0x12})
+	require.NoError(t, err)
+	require.Nil(t, payload)
+	payload, err = s.ExecutionPayload(ctx, 10, phase0.Hash32{0x11})
+	require.NoError(t, err)
+	require.Nil(t, payload)
+
+	// The builder of the bid is the submitting builder rather than the relay.
+	builder, err := s.BidBuilder(ctx, 10, phase0.Hash32{0x12})
+	require.NoError(t, err)
+	require.NotNil(t, builder)
+	require.Equal(t, pubkey(t, builderKey), *builder)
+	builder, err = s.BidBuilder(ctx, 11, phase0.Hash32{0x12})
+	require.NoError(t, err)
+	require.Nil(t, builder)
+
+	// Other auctions are unaffected.
+	bid, err = s.BuilderBid(ctx, 10, phase0.Hash32{0x02}, pubkey(t, proposerKey))
+	require.NoError(t, err)
+	require.Nil(t, bid)
+}
+
+func TestSubmitBlockCapella(t *testing.T) {
+	ctx := context.Background()
+
+	s := newService(t)
+
+	bidTrace := testBidTrace(t, 10, 0x10, 1)
+	denebPayload := testPayload(bidTrace)
+	request := &spec.VersionedSubmitBlockRequest{
+		Version: consensusspec.DataVersionCapella,
+		Capella: &builderapicapella.SubmitBlockRequest{
+			Message: bidTrace,
+			ExecutionPayload: &capella.ExecutionPayload{
+				ParentHash:    denebPayload.ParentHash,
+				FeeRecipient:  denebPayload.FeeRecipient,
+				BlockNumber:   denebPayload.BlockNumber,
+				GasLimit:      denebPayload.GasLimit,
+				GasUsed:       denebPayload.GasUsed,
+				Timestamp:     denebPayload.Timestamp,
+				BaseFeePerGas: [32]byte{0x07},
+				BlockHash:     denebPayload.BlockHash,
+				Transactions:  denebPayload.Transactions,
+				Withdrawals:   denebPayload.Withdrawals,
+			},
+			Signature: sign(t, bidTrace),
+		},
+	}
+
+	// Capella payloads cannot be unblinded, so their submissions are rejected.
+	err := s.SubmitBlock(ctx, request)
+	require.EqualError(t, err, "unsupported submission version capella: invalid options")
+	require.ErrorIs(t, err, relay.ErrInvalidOptions)
+
+	bid, err := s.BuilderBid(ctx, 10, testParentHash, pubkey(t, proposerKey))
+	require.NoError(t, err)
+	require.Nil(t, bid)
+}
+
+func TestSubmitBlockRecordsBids(t *testing.T) {
+	ctx := context.Background()
+
+	recorder, err := standardbidtracerecorder.New(ctx,
+		standardbidtracerecorder.WithLogLevel(zerolog.Disabled),
+	)
+	require.NoError(t, err)
+
+	s := newService(t, standard.WithBidTraceRecorder(recorder))
+
+	require.NoError(t, s.SubmitBlock(ctx, testRequest(t, testBidTrace(t, 10, 0x10, 2))))
+	// Invalid submissions are not recorded.
+	request := testRequest(t, testBidTrace(t, 10, 0x11, 3))
+	request.Electra.Signature = phase0.BLSSignature{}
+	require.Error(t, s.SubmitBlock(ctx, request))
+
+	slot := phase0.Slot(10)
+	traces, err := recorder.ReceivedBids(ctx, &bidtracerecorder.ReceivedBidsFilter{Slot: &slot})
+	require.NoError(t, err)
+	require.Len(t, traces, 1)
+	require.Equal(t, phase0.Hash32{0x10}, traces[0].BlockHash)
+	// Traces record the builder, not the relay.
+	require.Equal(t, pubkey(t, builderKey), traces[0].BuilderPubkey)
+	require.Equal(t, pubkey(t, proposerKey), traces[0].ProposerPubkey)
+	require.False(t, traces[0].OptimisticSubmission)
+}
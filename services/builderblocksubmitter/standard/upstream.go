@@ -0,0 +1,100 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+
+	builderclient "github.com/attestantio/go-builder-client"
+	builderapi "github.com/attestantio/go-builder-client/api"
+	"github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// upstreamName is the name of the submitter when acting as an upstream provider.
+const upstreamName = "builderblocksubmitter"
+
+// upstream exposes the submitter as an upstream builder bid provider.
+type upstream struct {
+	s *Service
+}
+
+// UpstreamProvider provides the submitter as an upstream builder bid provider, allowing
+// submitted blocks to be auctioned alongside the bids of other upstream providers.
+// The provider also supplies the execution payloads and builders behind its bids.
+func (s *Service) UpstreamProvider() builderclient.BuilderBidProvider {
+	return &upstream{
+		s: s,
+	}
+}
+
+// Name returns the name of the provider.
+func (u *upstream) Name() string {
+	return upstreamName
+}
+
+// Address returns the address of the provider.
+// Submissions are held in-process, so the provider has no address.
+func (u *upstream) Address() string {
+	return ""
+}
+
+// Pubkey returns the public key with which the provider's bids are signed.
+func (u *upstream) Pubkey() *phase0.BLSPubKey {
+	pubkey := u.s.pubkey
+
+	return &pubkey
+}
+
+// BuilderBid provides the best bid submitted for the given options.
+func (u *upstream) BuilderBid(ctx context.Context,
+	opts *builderapi.BuilderBidOpts,
+) (
+	*builderapi.Response[*spec.VersionedSignedBuilderBid],
+	error,
+) {
+	bid, err := u.s.BuilderBid(ctx, opts.Slot, opts.ParentHash, opts.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &builderapi.Response[*spec.VersionedSignedBuilderBid]{
+		Data:     bid,
+		Metadata: make(map[string]any),
+	}, nil
+}
+
+// ExecutionPayload provides the execution payload and blobs bundle for the bid with the given block hash.
+// It returns nil if the payload is not known.
+func (u *upstream) ExecutionPayload(ctx context.Context,
+	slot phase0.Slot,
+	blockHash phase0.Hash32,
+) (
+	*builderapi.VersionedSubmitBlindedBlockResponse,
+	error,
+) {
+	return u.s.ExecutionPayload(ctx, slot, blockHash)
+}
+
+// BidBuilder provides the public key of the builder that submitted the bid with the given block hash.
+// It returns nil if the builder is not known.
+func (u *upstream) BidBuilder(ctx context.Context,
+	slot phase0.Slot,
+	blockHash phase0.Hash32,
+) (
+	*phase0.BLSPubKey,
+	error,
+) {
+	return u.s.BidBuilder(ctx, slot, blockHash)
+}
@@ -0,0 +1,322 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-block-relay/loggers"
+	"github.com/attestantio/go-block-relay/services/bidtracerecorder"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) getDeliveredPayloads(w http.ResponseWriter, r *http.Request) {
+	log := loggers.WithRequestID(r.Context(), s.log)
+	log.Trace().Msg("getDeliveredPayloads called")
+
+	provider, isProvider := s.bidTraceRecorder.(bidtracerecorder.DeliveredPayloadProvider)
+	if !isProvider {
+		log.Debug().Msg("Bid trace recorder does not provide delivered payloads")
+		s.sendResponse(r.Context(), w,
+			http.StatusNotFound,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusNotFound,
+				Message: "Request not supported by service",
+			})
+		s.monitorRequestHandled("delivered payloads", "failure")
+
+		return
+	}
+
+	filter, err := parseDeliveredPayloadsFilter(r.URL.Query())
+	if err != nil {
+		log.Debug().Err(err).Msg("Invalid query")
+		s.sendResponse(r.Context(), w,
+			http.StatusBadRequest,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			})
+		s.monitorRequestHandled("delivered payloads", "failure")
+
+		return
+	}
+
+	traces, err := provider.DeliveredPayloads(r.Context(), filter)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to obtain delivered payloads")
+		s.sendResponse(r.Context(), w,
+			http.StatusInternalServerError,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusInternalServerError,
+				Message: "Failed to obtain delivered payloads",
+			})
+		s.monitorRequestHandled("delivered payloads", "failure")
+
+		return
+	}
+
+	s.monitorRequestHandled("delivered payloads", "success")
+	s.sendResponse(r.Context(), w,
+		http.StatusOK,
+		map[string]string{},
+		traces,
+	)
+}
+
+// parseDeliveredPayloadsFilter parses the filter for delivered payloads from query parameters.
+func parseDeliveredPayloadsFilter(query url.Values) (*bidtracerecorder.DeliveredPayloadsFilter, error) {
+	var err error
+
+	filter := &bidtracerecorder.DeliveredPayloadsFilter{}
+
+	filter.Slot, err = parseQuerySlot(query, "slot")
+	if err != nil {
+		return nil, err
+	}
+
+	filter.Cursor, err = parseQuerySlot(query, "cursor")
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.Slot != nil && filter.Cursor != nil {
+		return nil, errors.New("cannot specify both slot and cursor")
+	}
+
+	filter.BlockHash, err = parseQueryHash(query, "block_hash")
+	if err != nil {
+		return nil, err
+	}
+
+	filter.BlockNumber, err = parseQueryUint64(query, "block_number")
+	if err != nil {
+		return nil, err
+	}
+
+	filter.ProposerPubkey, err = parseQueryPubkey(query, "proposer_pubkey")
+	if err != nil {
+		return nil, err
+	}
+
+	filter.BuilderPubkey, err = parseQueryPubkey(query, "builder_pubkey")
+	if err != nil {
+		return nil, err
+	}
+
+	filter.Limit, err = parseQueryLimit(query)
+	if err != nil {
+		return nil, err
+	}
+
+	switch query.Get("order_by") {
+	case "":
+		filter.Order = bidtracerecorder.OrderSlotDescending
+	case "value":
+		filter.Order = bidtracerecorder.OrderValueAscending
+	case "-value":
+		filter.Order = bidtracerecorder.OrderValueDescending
+	default:
+		return nil, fmt.Errorf("invalid order_by %s", query.Get("order_by"))
+	}
+
+	return filter, nil
+}
+
+func (s *Service) getReceivedBids(w http.ResponseWriter, r *http.Request) {
+	log := loggers.WithRequestID(r.Context(), s.log)
+	log.Trace().Msg("getReceivedBids called")
+
+	provider, isProvider := s.bidTraceRecorder.(bidtracerecorder.ReceivedBidProvider)
+	if !isProvider {
+		log.Debug().Msg("Bid trace recorder does not provide received bids")
+		s.sendResponse(r.Context(), w,
+			http.StatusNotFound,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusNotFound,
+				Message: "Request not supported by service",
+			})
+		s.monitorRequestHandled("received bids", "failure")
+
+		return
+	}
+
+	filter, err := parseReceivedBidsFilter(r.URL.Query())
+	if err != nil {
+		log.Debug().Err(err).Msg("Invalid query")
+		s.sendResponse(r.Context(), w,
+			http.StatusBadRequest,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			})
+		s.monitorRequestHandled("received bids", "failure")
+
+		return
+	}
+
+	traces, err := provider.ReceivedBids(r.Context(), filter)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to obtain received bids")
+		s.sendResponse(r.Context(), w,
+			http.StatusInternalServerError,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusInternalServerError,
+				Message: "Failed to obtain received bids",
+			})
+		s.monitorRequestHandled("received bids", "failure")
+
+		return
+	}
+
+	s.monitorRequestHandled("received bids", "success")
+	s.sendResponse(r.Context(), w,
+		http.StatusOK,
+		map[string]string{},
+		traces,
+	)
+}
+
+// parseReceivedBidsFilter parses the filter for received bids from query parameters.
+func parseReceivedBidsFilter(query url.Values) (*bidtracerecorder.ReceivedBidsFilter, error) {
+	var err error
+
+	filter := &bidtracerecorder.ReceivedBidsFilter{}
+
+	filter.Slot, err = parseQuerySlot(query, "slot")
+	if err != nil {
+		return nil, err
+	}
+
+	filter.BlockHash, err = parseQueryHash(query, "block_hash")
+	if err != nil {
+		return nil, err
+	}
+
+	filter.BlockNumber, err = parseQueryUint64(query, "block_number")
+	if err != nil {
+		return nil, err
+	}
+
+	filter.BuilderPubkey, err = parseQueryPubkey(query, "builder_pubkey")
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.Slot == nil && filter.BlockHash == nil && filter.BlockNumber == nil && filter.BuilderPubkey == nil {
+		// Listing every bid received would be too large a response.
+		return nil, errors.New("must specify at least one of slot, block_hash, block_number or builder_pubkey")
+	}
+
+	filter.Limit, err = parseQueryLimit(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+// parseQueryLimit parses the optional limit query parameter, returning the maximum if not present.
+func parseQueryLimit(query url.Values) (int, error) {
+	limit, err := parseQueryUint64(query, "limit")
+	if err != nil {
+		return 0, err
+	}
+
+	if limit == nil {
+		return bidtracerecorder.MaxQueryLimit, nil
+	}
+
+	if *limit == 0 {
+		return 0, errors.New("limit must be greater than 0")
+	}
+
+	if *limit > bidtracerecorder.MaxQueryLimit {
+		return 0, fmt.Errorf("limit cannot be greater than %d", bidtracerecorder.MaxQueryLimit)
+	}
+
+	return int(*limit), nil
+}
+
+// parseQueryUint64 parses an optional unsigned integer query parameter.
+func parseQueryUint64(query url.Values, name string) (*uint64, error) {
+	if !query.Has(name) {
+		return nil, nil
+	}
+
+	val, err := strconv.ParseUint(query.Get(name), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %s", name, query.Get(name))
+	}
+
+	return &val, nil
+}
+
+// parseQuerySlot parses an optional slot query parameter.
+func parseQuerySlot(query url.Values, name string) (*phase0.Slot, error) {
+	val, err := parseQueryUint64(query, name)
+	if err != nil || val == nil {
+		return nil, err
+	}
+
+	slot := phase0.Slot(*val)
+
+	return &slot, nil
+}
+
+// parseQueryHash parses an optional hash query parameter.
+func parseQueryHash(query url.Values, name string) (*phase0.Hash32, error) {
+	if !query.Has(name) {
+		return nil, nil
+	}
+
+	data, err := hex.DecodeString(strings.TrimPrefix(query.Get(name), "0x"))
+	if err != nil || len(data) != phase0.Hash32Length {
+		return nil, fmt.Errorf("invalid %s %s", name, query.Get(name))
+	}
+
+	hash := phase0.Hash32{}
+	copy(hash[:], data)
+
+	return &hash, nil
+}
+
+// parseQueryPubkey parses an optional public key query parameter.
+func parseQueryPubkey(query url.Values, name string) (*phase0.BLSPubKey, error) {
+	if !query.Has(name) {
+		return nil, nil
+	}
+
+	data, err := hex.DecodeString(strings.TrimPrefix(query.Get(name), "0x"))
+	if err != nil || len(data) != phase0.PublicKeyLength {
+		return nil, fmt.Errorf("invalid %s %s", name, query.Get(name))
+	}
+
+	pubkey := phase0.BLSPubKey{}
+	copy(pubkey[:], data)
+
+	return &pubkey, nil
+}
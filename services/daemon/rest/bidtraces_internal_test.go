@@ -0,0 +1,338 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	standardbidtracerecorder "github.com/attestantio/go-block-relay/services/bidtracerecorder/standard"
+	"github.com/attestantio/go-block-relay/types"
+	builderelectra "github.com/attestantio/go-builder-client/api/electra"
+	builderspec "github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	consensusspec "github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// testTraceBid creates a bid for the given block hash and value.
+func testTraceBid(blockHash byte, value uint64) *builderspec.VersionedSignedBuilderBid {
+	return &builderspec.VersionedSignedBuilderBid{
+		Version: consensusspec.DataVersionElectra,
+		Electra: &builderelectra.SignedBuilderBid{
+			Message: &builderelectra.BuilderBid{
+				Header: &deneb.ExecutionPayloadHeader{
+					BlockNumber:   uint64(blockHash),
+					BaseFeePerGas: uint256.NewInt(0),
+					BlockHash:     phase0.Hash32{blockHash},
+				},
+				BlobKZGCommitments: make([]deneb.KZGCommitment, 0),
+				ExecutionRequests:  &electra.ExecutionRequests{},
+				Value:              uint256.NewInt(value),
+			},
+		},
+	}
+}
+
+// testTraceProposal creates an unblinded proposal for the given block hash.
+func testTraceProposal(blockHash byte) *api.VersionedSignedProposal {
+	return &api.VersionedSignedProposal{
+		Version: consensusspec.DataVersionElectra,
+		Electra: &apiv1electra.SignedBlockContents{
+			SignedBlock: &electra.SignedBeaconBlock{
+				Message: &electra.BeaconBlock{
+					Body: &electra.BeaconBlockBody{
+						ExecutionPayload: &deneb.ExecutionPayload{
+							BlockHash: phase0.Hash32{blockHash},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGetDeliveredPayloads(t *testing.T) {
+	ctx := context.Background()
+
+	recorder, err := standardbidtracerecorder.New(ctx,
+		standardbidtracerecorder.WithLogLevel(zerolog.Disabled),
+	)
+	require.NoError(t, err)
+
+	s := &Service{
+		log:              zerolog.Nop(),
+		bidTraceRecorder: recorder,
+	}
+
+	for slot := phase0.Slot(1); slot <= 3; slot++ {
+		blockHash := byte(0x10 + slot)
+		s.recordServedBid(ctx, slot, phase0.BLSPubKey{byte(slot)}, testTraceBid(blockHash, uint64(slot)*1000))
+		s.recordDeliveredPayload(ctx, testTraceProposal(blockHash))
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		statusCode int
+		slots      []phase0.Slot
+	}{
+		{
+			name:       "All",
+			statusCode: http.StatusOK,
+			slots:      []phase0.Slot{3, 2, 1},
+		},
+		{
+			name:       "Slot",
+			query:      "?slot=2",
+			statusCode: http.StatusOK,
+			slots:      []phase0.Slot{2},
+		},
+		{
+			name:       "SlotInvalid",
+			query:      "?slot=invalid",
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "SlotAndCursor",
+			query:      "?slot=2&cursor=2",
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "Cursor",
+			query:      "?cursor=2",
+			statusCode: http.StatusOK,
+			slots:      []phase0.Slot{2, 1},
+		},
+		{
+			name:       "BlockHash",
+			query:      "?block_hash=0x1300000000000000000000000000000000000000000000000000000000000000",
+			statusCode: http.StatusOK,
+			slots:      []phase0.Slot{3},
+		},
+		{
+			name:       "BlockHashInvalid",
+			query:      "?block_hash=0x13",
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "BlockNumber",
+			query:      "?block_number=17",
+			statusCode: http.StatusOK,
+			slots:      []phase0.Slot{1},
+		},
+		{
+			name:       "ProposerPubkey",
+			query:      "?proposer_pubkey=0x020000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+			statusCode: http.StatusOK,
+			slots:      []phase0.Slot{2},
+		},
+		{
+			name:       "ProposerPubkeyInvalid",
+			query:      "?proposer_pubkey=invalid",
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "BuilderPubkey",
+			query:      "?builder_pubkey=0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+			statusCode: http.StatusOK,
+			slots:      []phase0.Slot{3, 2, 1},
+		},
+		{
+			name:       "Limit",
+			query:      "?limit=1",
+			statusCode: http.StatusOK,
+			slots:      []phase0.Slot{3},
+		},
+		{
+			name:       "LimitTooHigh",
+			query:      "?limit=201",
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "LimitZero",
+			query:      "?limit=0",
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "OrderByValue",
+			query:      "?order_by=value",
+			statusCode: http.StatusOK,
+			slots:      []phase0.Slot{1, 2, 3},
+		},
+		{
+			name:       "OrderByValueDescending",
+			query:      "?order_by=-value",
+			statusCode: http.StatusOK,
+			slots:      []phase0.Slot{3, 2, 1},
+		},
+		{
+			name:       "OrderByInvalid",
+			query:      "?order_by=slot",
+			statusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			writer := httptest.NewRecorder()
+			s.getDeliveredPayloads(writer, httptest.NewRequest(http.MethodGet, "/relay/v1/data/bidtraces/proposer_payload_delivered"+test.query, nil))
+			require.Equal(t, test.statusCode, writer.Code)
+			if test.statusCode != http.StatusOK {
+				return
+			}
+
+			traces := make([]*types.BidTrace, 0)
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &traces))
+			slots := make([]phase0.Slot, 0, len(traces))
+			for _, trace := range traces {
+				slots = append(slots, trace.Slot)
+			}
+			require.Equal(t, test.slots, slots)
+		})
+	}
+}
+
+func TestGetDeliveredPayloadsUnsupported(t *testing.T) {
+	ctx := context.Background()
+
+	s := &Service{
+		log: zerolog.Nop(),
+	}
+
+	// Recording without a recorder does nothing.
+	s.recordServedBid(ctx, 1, phase0.BLSPubKey{}, testTraceBid(0x10, 1000))
+	s.recordDeliveredPayload(ctx, testTraceProposal(0x10))
+
+	writer := httptest.NewRecorder()
+	s.getDeliveredPayloads(writer, httptest.NewRequest(http.MethodGet, "/relay/v1/data/bidtraces/proposer_payload_delivered", nil))
+	require.Equal(t, http.StatusNotFound, writer.Code)
+}
+
+func TestGetReceivedBids(t *testing.T) {
+	ctx := context.Background()
+
+	recorder, err := standardbidtracerecorder.New(ctx,
+		standardbidtracerecorder.WithLogLevel(zerolog.Disabled),
+	)
+	require.NoError(t, err)
+
+	s := &Service{
+		log:              zerolog.Nop(),
+		bidTraceRecorder: recorder,
+	}
+
+	start := time.Unix(1700000000, 0)
+	for i := range 3 {
+		slot := phase0.Slot(1 + i/2)
+		require.NoError(t, recorder.RecordReceivedBid(ctx,
+			slot,
+			phase0.BLSPubKey{0x01},
+			testTraceBid(byte(0x10+i), 1000),
+			start.Add(time.Duration(i)*time.Second),
+			false,
+		))
+	}
+
+	tests := []struct {
+		name        string
+		query       string
+		statusCode  int
+		blockHashes []byte
+	}{
+		{
+			name:       "FilterMissing",
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "LimitOnly",
+			query:      "?limit=1",
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "Slot",
+			query:       "?slot=1",
+			statusCode:  http.StatusOK,
+			blockHashes: []byte{0x11, 0x10},
+		},
+		{
+			name:        "SlotLimit",
+			query:       "?slot=1&limit=1",
+			statusCode:  http.StatusOK,
+			blockHashes: []byte{0x11},
+		},
+		{
+			name:        "BlockHash",
+			query:       "?block_hash=0x1200000000000000000000000000000000000000000000000000000000000000",
+			statusCode:  http.StatusOK,
+			blockHashes: []byte{0x12},
+		},
+		{
+			name:        "BlockNumber",
+			query:       "?block_number=17",
+			statusCode:  http.StatusOK,
+			blockHashes: []byte{0x11},
+		},
+		{
+			name:        "BuilderPubkey",
+			query:       "?builder_pubkey=0x000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+			statusCode:  http.StatusOK,
+			blockHashes: []byte{0x12, 0x11, 0x10},
+		},
+		{
+			name:       "BuilderPubkeyInvalid",
+			query:      "?builder_pubkey=0x00",
+			statusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			writer := httptest.NewRecorder()
+			s.getReceivedBids(writer, httptest.NewRequest(http.MethodGet, "/relay/v1/data/bidtraces/builder_blocks_received"+test.query, nil))
+			require.Equal(t, test.statusCode, writer.Code)
+			if test.statusCode != http.StatusOK {
+				return
+			}
+
+			traces := make([]*types.ReceivedBidTrace, 0)
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &traces))
+			blockHashes := make([]byte, 0, len(traces))
+			for _, trace := range traces {
+				blockHashes = append(blockHashes, trace.BlockHash[0])
+			}
+			require.Equal(t, test.blockHashes, blockHashes)
+		})
+	}
+}
+
+func TestGetReceivedBidsUnsupported(t *testing.T) {
+	s := &Service{
+		log: zerolog.Nop(),
+	}
+
+	writer := httptest.NewRecorder()
+	s.getReceivedBids(writer, httptest.NewRequest(http.MethodGet, "/relay/v1/data/bidtraces/builder_blocks_received?slot=1", nil))
+	require.Equal(t, http.StatusNotFound, writer.Code)
+}
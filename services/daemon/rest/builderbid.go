@@ -1,4 +1,4 @@
-// Copyright © 2022 - 2025 Attestant Limited.
+// Copyright © 2022 - 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -14,35 +14,58 @@
 package rest
 
 import (
+	"context"
 	"encoding/hex"
-	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	relay "github.com/attestantio/go-block-relay"
+	"github.com/attestantio/go-block-relay/loggers"
+	"github.com/attestantio/go-builder-client/spec"
+	consensusspec "github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/phase0"
 	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func (s *Service) getBuilderBid(w http.ResponseWriter, r *http.Request) {
-	s.log.Trace().Msg("getBuilderBid called")
+	log := loggers.WithRequestID(r.Context(), s.log)
+	log.Trace().Msg("getBuilderBid called")
+
+	contentType, err := s.obtainAcceptedContentType(r.Context(), r, contentTypeJSON, contentTypeSSZ)
+	if err != nil {
+		log.Debug().Err(err).Msg("Unsupported accept header")
+		s.sendResponse(r.Context(), w,
+			http.StatusNotAcceptable,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusNotAcceptable,
+				Message: err.Error(),
+			})
+		s.monitorRequestHandled("builder bid", "failure")
+
+		return
+	}
 
 	// Obtain path variables.
 	vars := mux.Vars(r)
 
 	tmpInt, err := strconv.ParseUint(vars["slot"], 10, 64)
 	if err != nil {
-		s.log.Debug().Err(err).Str("slot", vars["slot"]).Msg("Invalid slot")
-		s.sendResponse(w,
+		log.Debug().Err(err).Str("slot", vars["slot"]).Msg("Invalid slot")
+		s.sendResponse(r.Context(), w,
 			http.StatusBadRequest,
 			map[string]string{},
 			&APIResponse{
 				Code:    http.StatusBadRequest,
 				Message: fmt.Sprintf("invalid slot %s", vars["slot"]),
 			})
-		monitorRequestHandled("builder bid", "failure")
+		s.monitorRequestHandled("builder bid", "failure")
 
 		return
 	}
@@ -51,15 +74,15 @@ func (s *Service) getBuilderBid(w http.ResponseWriter, r *http.Request) {
 
 	tmpBytes, err := hex.DecodeString(strings.TrimPrefix(vars["parenthash"], "0x"))
 	if err != nil {
-		s.log.Debug().Err(err).Str("parenthash", vars["parenthash"]).Msg("Invalid parent hash")
-		s.sendResponse(w,
+		log.Debug().Err(err).Str("parenthash", vars["parenthash"]).Msg("Invalid parent hash")
+		s.sendResponse(r.Context(), w,
 			http.StatusBadRequest,
 			map[string]string{},
 			&APIResponse{
 				Code:    http.StatusBadRequest,
 				Message: fmt.Sprintf("invalid parent hash %s", vars["parenthash"]),
 			})
-		monitorRequestHandled("builder bid", "failure")
+		s.monitorRequestHandled("builder bid", "failure")
 
 		return
 	}
@@ -69,15 +92,15 @@ func (s *Service) getBuilderBid(w http.ResponseWriter, r *http.Request) {
 
 	tmpBytes, err = hex.DecodeString(strings.TrimPrefix(vars["pubkey"], "0x"))
 	if err != nil {
-		s.log.Trace().Err(err).Str("pubkey", vars["pubkey"]).Msg("Invalid public key")
-		s.sendResponse(w,
+		log.Trace().Err(err).Str("pubkey", vars["pubkey"]).Msg("Invalid public key")
+		s.sendResponse(r.Context(), w,
 			http.StatusBadRequest,
 			map[string]string{},
 			&APIResponse{
 				Code:    http.StatusBadRequest,
 				Message: fmt.Sprintf("invalid public key %s", vars["pubkey"]),
 			})
-		monitorRequestHandled("builder bid", "failure")
+		s.monitorRequestHandled("builder bid", "failure")
 
 		return
 	}
@@ -85,41 +108,306 @@ func (s *Service) getBuilderBid(w http.ResponseWriter, r *http.Request) {
 	pubkey := phase0.BLSPubKey{}
 	copy(pubkey[:], tmpBytes)
 
-	bid, err := s.builderBidProvider.BuilderBid(r.Context(), slot, parentHash, pubkey)
+	trace.SpanFromContext(r.Context()).SetAttributes(
+		attribute.Int64("slot", int64(slot)),
+		attribute.String("parent_hash", parentHash.String()),
+		attribute.String("pubkey", pubkey.String()),
+	)
+
+	ctx, cancel := context.WithDeadline(r.Context(), s.bidDeadline(r))
+	defer cancel()
+
+	bid, err := s.obtainBuilderBidByDeadline(ctx, slot, parentHash, pubkey)
+	if errors.Is(err, context.DeadlineExceeded) {
+		// A late bid is worse than no bid, as the proposer may miss their slot waiting for it.
+		log.Debug().Uint64("slot", uint64(slot)).Msg("Deadline passed before bid obtained")
+		s.monitorRequestHandled("builder bid", "timeout")
+		s.sendResponse(r.Context(), w,
+			http.StatusNoContent,
+			map[string]string{},
+			nil,
+		)
+
+		return
+	}
+
 	if err != nil {
 		code := http.StatusInternalServerError
 		if errors.Is(err, relay.ErrInvalidOptions) {
 			code = http.StatusBadRequest
 		}
 
-		s.log.Error().Err(err).Msg("Failed to obtain bid")
-		s.sendResponse(w,
+		log.Error().Err(err).Msg("Failed to obtain bid")
+		s.sendResponse(r.Context(), w,
 			http.StatusInternalServerError,
 			map[string]string{},
 			&APIResponse{
 				Code:    code,
 				Message: "Failed to obtain bid",
 			})
-		monitorRequestHandled("builder bid", "failure")
+		s.monitorRequestHandled("builder bid", "failure")
 
 		return
 	}
 
-	monitorRequestHandled("builder bid", "success")
-
 	if bid == nil {
-		s.sendResponse(w,
+		s.monitorRequestHandled("builder bid", "success")
+		s.sendResponse(r.Context(), w,
 			http.StatusNoContent,
 			map[string]string{},
 			nil,
 		)
-	} else {
-		headers := map[string]string{}
-		headers[EthConsensusVersion] = bid.Version.String()
-		s.sendResponse(w,
+
+		return
+	}
+
+	headers := map[string]string{}
+	headers[EthConsensusVersion] = bid.Version.String()
+
+	if contentType == contentTypeSSZ {
+		data, err := s.marshalBuilderBidSSZ(r.Context(), bid)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to generate SSZ output")
+			s.sendResponse(r.Context(), w,
+				http.StatusInternalServerError,
+				map[string]string{},
+				&APIResponse{
+					Code:    http.StatusInternalServerError,
+					Message: "Failed to generate output",
+				})
+			s.monitorRequestHandled("builder bid", "failure")
+
+			return
+		}
+
+		s.monitorRequestHandled("builder bid", "success")
+		s.sendSSZResponse(r.Context(), w,
 			http.StatusOK,
 			headers,
-			bid,
+			data,
 		)
+
+		return
+	}
+
+	s.monitorRequestHandled("builder bid", "success")
+	s.sendResponse(r.Context(), w,
+		http.StatusOK,
+		headers,
+		bid,
+	)
+}
+
+// bidDeadline calculates the deadline for obtaining a bid.
+// If the request specifies a timeout it runs from the time the request was sent, if
+// supplied, or else from now.  The deadline is capped by the server's maximum bid timeout.
+func (s *Service) bidDeadline(r *http.Request) time.Time {
+	now := time.Now()
+	maxDeadline := now.Add(s.maxBidTimeout)
+
+	timeoutMs, err := strconv.ParseInt(r.Header.Get(TimeoutMs), 10, 64)
+	if err != nil || timeoutMs <= 0 {
+		return maxDeadline
+	}
+
+	start := now
+
+	dateMs, err := strconv.ParseInt(r.Header.Get(DateMilliseconds), 10, 64)
+	if err == nil && dateMs > 0 {
+		start = time.UnixMilli(dateMs)
+		if start.After(now) {
+			// Client clock is ahead of ours; do not extend the deadline.
+			start = now
+		}
+	}
+
+	deadline := start.Add(time.Duration(timeoutMs) * time.Millisecond)
+	if deadline.After(maxDeadline) {
+		return maxDeadline
+	}
+
+	return deadline
+}
+
+// obtainBuilderBidByDeadline obtains the bid to serve and records its payload,
+// abandoning the attempt if the context's deadline passes first.
+func (s *Service) obtainBuilderBidByDeadline(ctx context.Context,
+	slot phase0.Slot,
+	parentHash phase0.Hash32,
+	pubkey phase0.BLSPubKey,
+) (
+	*spec.VersionedSignedBuilderBid,
+	error,
+) {
+	type result struct {
+		bid *spec.VersionedSignedBuilderBid
+		err error
+	}
+
+	// Channel is buffered so that a late result does not block its goroutine.
+	resCh := make(chan *result, 1)
+	go func() {
+		bid, err := s.obtainBuilderBid(ctx, slot, parentHash, pubkey)
+		if err == nil && bid != nil {
+			// Serving a bid that cannot later be unblinded would cost the proposer their block,
+			// so such a bid is dropped and the proposer left to build their own block.
+			if err := s.recordPayload(ctx, slot, pubkey, bid); err != nil {
+				log := loggers.WithRequestID(ctx, s.log)
+				log.Warn().Err(err).Uint64("slot", uint64(slot)).Msg("Failed to record payload for bid; dropping bid")
+				bid = nil
+			}
+		}
+		if err == nil && bid != nil {
+			s.recordServedBid(ctx, slot, pubkey, bid)
+		}
+		resCh <- &result{
+			bid: bid,
+			err: err,
+		}
+	}()
+
+	select {
+	case res := <-resCh:
+		return res.bid, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// obtainBuilderBid obtains the bid to serve, by auction if auction bids are
+// enabled and from the builder bid provider otherwise.
+func (s *Service) obtainBuilderBid(ctx context.Context,
+	slot phase0.Slot,
+	parentHash phase0.Hash32,
+	pubkey phase0.BLSPubKey,
+) (
+	*spec.VersionedSignedBuilderBid,
+	error,
+) {
+	log := loggers.WithRequestID(ctx, s.log)
+
+	if s.auctioneer == nil {
+		return s.builderBidProvider.BuilderBid(ctx, slot, parentHash, pubkey)
+	}
+
+	res, err := s.auctioneer.AuctionBlock(ctx, slot, parentHash, pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil || res.WinningParticipation == nil {
+		return nil, nil
+	}
+
+	log.Trace().
+		Uint64("slot", uint64(slot)).
+		Str("category", res.WinningParticipation.Category).
+		Stringer("score", res.WinningParticipation.Score).
+		Int("providers", len(res.Providers)).
+		Msg("Auction complete")
+
+	return res.WinningParticipation.Bid, nil
+}
+
+// recordPayload records the execution payload behind a bid with the block
+// unblinder, if both the source of the bid and the unblinder support it.
+// A payload that is not available is an error, as the bid could not be unblinded.
+func (s *Service) recordPayload(ctx context.Context,
+	slot phase0.Slot,
+	pubkey phase0.BLSPubKey,
+	bid *spec.VersionedSignedBuilderBid,
+) error {
+	if s.payloadProvider == nil || s.payloadRecorder == nil {
+		return nil
+	}
+
+	blockHash, err := bid.BlockHash()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain block hash")
+	}
+
+	payload, err := s.payloadProvider.ExecutionPayload(ctx, slot, blockHash)
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain payload")
+	}
+
+	if payload == nil {
+		return fmt.Errorf("no payload available for block %#x", blockHash)
+	}
+
+	return s.payloadRecorder.RecordPayload(ctx, slot, pubkey, payload)
+}
+
+// recordServedBid records a bid served to a proposer with the bid trace recorder, if present.
+// Failure to record is not fatal, as it only affects the relay data API.
+func (s *Service) recordServedBid(ctx context.Context,
+	slot phase0.Slot,
+	pubkey phase0.BLSPubKey,
+	bid *spec.VersionedSignedBuilderBid,
+) {
+	if s.bidTraceRecorder == nil {
+		return
+	}
+
+	err := s.recordServedBidTrace(ctx, slot, pubkey, bid)
+	if err != nil {
+		log := loggers.WithRequestID(ctx, s.log)
+		log.Warn().Err(err).Uint64("slot", uint64(slot)).Msg("Failed to record served bid")
+	}
+}
+
+// recordServedBidTrace records the trace of a served bid, attributing it to the builder that
+// created the bid rather than to any relay that signed it on the builder's behalf.
+func (s *Service) recordServedBidTrace(ctx context.Context,
+	slot phase0.Slot,
+	pubkey phase0.BLSPubKey,
+	bid *spec.VersionedSignedBuilderBid,
+) error {
+	builder, err := bid.Builder()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain builder")
+	}
+
+	if s.bidBuilderProvider != nil {
+		blockHash, err := bid.BlockHash()
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain block hash")
+		}
+
+		bidBuilder, err := s.bidBuilderProvider.BidBuilder(ctx, slot, blockHash)
+		if err != nil {
+			return errors.Wrap(err, "failed to obtain bid builder")
+		}
+		if bidBuilder != nil {
+			builder = *bidBuilder
+		}
+	}
+
+	return s.bidTraceRecorder.RecordServedBid(ctx, slot, pubkey, builder, bid)
+}
+
+func (s *Service) marshalBuilderBidSSZ(_ context.Context,
+	bid *spec.VersionedSignedBuilderBid,
+) (
+	[]byte,
+	error,
+) {
+	if bid.IsEmpty() {
+		return nil, fmt.Errorf("no data for version %v", bid.Version)
+	}
+
+	switch bid.Version {
+	case consensusspec.DataVersionBellatrix:
+		return bid.Bellatrix.MarshalSSZ()
+	case consensusspec.DataVersionCapella:
+		return bid.Capella.MarshalSSZ()
+	case consensusspec.DataVersionDeneb:
+		return bid.Deneb.MarshalSSZ()
+	case consensusspec.DataVersionElectra:
+		return bid.Electra.MarshalSSZ()
+	case consensusspec.DataVersionFulu:
+		return bid.Fulu.MarshalSSZ()
+	default:
+		return nil, fmt.Errorf("unsupported bid version %v", bid.Version)
 	}
 }
@@ -0,0 +1,516 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+	mockauctioneer "github.com/attestantio/go-block-relay/services/blockauctioneer/mock"
+	mockblockunblinder "github.com/attestantio/go-block-relay/services/blockunblinder/mock"
+	"github.com/attestantio/go-block-relay/services/builderbidprovider"
+	mockbuilderbidprovider "github.com/attestantio/go-block-relay/services/builderbidprovider/mock"
+	nullmetrics "github.com/attestantio/go-block-relay/services/metrics/null"
+	mockvalidatorregistrar "github.com/attestantio/go-block-relay/services/validatorregistrar/mock"
+	builderapi "github.com/attestantio/go-builder-client/api"
+	builderapielectra "github.com/attestantio/go-builder-client/api/electra"
+	"github.com/attestantio/go-builder-client/spec"
+	consensusspec "github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+	"github.com/holiman/uint256"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBuilderBidAccept(t *testing.T) {
+	ctx := context.Background()
+
+	service, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithMonitor(nullmetrics.New()),
+		WithListenAddress(":14739"),
+		WithValidatorRegistrar(mockvalidatorregistrar.New()),
+		WithBlockAuctioneer(mockauctioneer.New()),
+		WithBlockUnblinder(mockblockunblinder.New()),
+		WithBuilderBidProvider(mockbuilderbidprovider.New()),
+	)
+	require.NoError(t, err)
+
+	vars := map[string]string{
+		"slot":       "1",
+		"parenthash": "0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+		"pubkey":     "0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f",
+	}
+
+	tests := []struct {
+		name       string
+		accept     []string
+		statusCode int
+	}{
+		{
+			name:       "Unsupported",
+			accept:     []string{"text/html"},
+			statusCode: http.StatusNotAcceptable,
+		},
+		{
+			name:       "JSON",
+			accept:     []string{"application/json"},
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "SSZ",
+			accept:     []string{"application/octet-stream"},
+			statusCode: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := mux.SetURLVars(&http.Request{
+				Header: http.Header{
+					"Accept": test.accept,
+				},
+			}, vars)
+			writer := httptest.NewRecorder()
+			service.getBuilderBid(writer, request)
+			require.Equal(t, test.statusCode, writer.Result().StatusCode)
+			if test.statusCode == http.StatusOK {
+				require.Equal(t, test.accept[0], writer.Result().Header.Get("Content-Type"))
+			}
+		})
+	}
+}
+
+// bidAuctioneer is a block auctioneer whose auctions are won by the bids of a builder bid provider.
+type bidAuctioneer struct {
+	provider builderbidprovider.Service
+}
+
+func (a *bidAuctioneer) AuctionBlock(ctx context.Context,
+	slot phase0.Slot,
+	parentHash phase0.Hash32,
+	pubkey phase0.BLSPubKey,
+) (
+	*blockauctioneer.Results,
+	error,
+) {
+	bid, err := a.provider.BuilderBid(ctx, slot, parentHash, pubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	participation := &blockauctioneer.Participation{
+		Category: "Standard",
+		Score:    big.NewInt(0),
+		Bid:      bid,
+	}
+
+	return &blockauctioneer.Results{
+		Participation: map[string]*blockauctioneer.Participation{
+			"provider": participation,
+		},
+		WinningParticipation: participation,
+	}, nil
+}
+
+func TestGetBuilderBidAuctioneer(t *testing.T) {
+	vars := map[string]string{
+		"slot":       "1",
+		"parenthash": "0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+		"pubkey":     "0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f",
+	}
+
+	tests := []struct {
+		name               string
+		auctioneer         blockauctioneer.BlockAuctioneer
+		builderBidProvider builderbidprovider.Service
+		statusCode         int
+	}{
+		{
+			name:               "Auctioneer",
+			auctioneer:         &bidAuctioneer{provider: mockbuilderbidprovider.New()},
+			builderBidProvider: mockbuilderbidprovider.NewErroring(),
+			statusCode:         http.StatusOK,
+		},
+		{
+			name:               "AuctioneerNoBids",
+			auctioneer:         mockauctioneer.New(),
+			builderBidProvider: mockbuilderbidprovider.New(),
+			statusCode:         http.StatusNoContent,
+		},
+		{
+			name:               "AuctioneerErroring",
+			auctioneer:         mockauctioneer.NewErroring(),
+			builderBidProvider: mockbuilderbidprovider.New(),
+			statusCode:         http.StatusInternalServerError,
+		},
+		{
+			name:               "Provider",
+			builderBidProvider: mockbuilderbidprovider.New(),
+			statusCode:         http.StatusOK,
+		},
+		{
+			name:               "ProviderErroring",
+			builderBidProvider: mockbuilderbidprovider.NewErroring(),
+			statusCode:         http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				log:                zerolog.Nop(),
+				maxBidTimeout:      time.Second,
+				auctioneer:         test.auctioneer,
+				builderBidProvider: test.builderBidProvider,
+			}
+
+			writer := httptest.NewRecorder()
+			s.getBuilderBid(writer, mux.SetURLVars(&http.Request{}, vars))
+			require.Equal(t, test.statusCode, writer.Result().StatusCode)
+		})
+	}
+}
+
+// recordingUnblinder is an unblinder that records payloads.
+type recordingUnblinder struct {
+	*mockblockunblinder.Service
+	err      error
+	payloads []*builderapi.VersionedSubmitBlindedBlockResponse
+}
+
+func (u *recordingUnblinder) RecordPayload(_ context.Context,
+	_ phase0.Slot,
+	_ phase0.BLSPubKey,
+	payload *builderapi.VersionedSubmitBlindedBlockResponse,
+) error {
+	if u.err != nil {
+		return u.err
+	}
+
+	u.payloads = append(u.payloads, payload)
+
+	return nil
+}
+
+// payloadlessProvider is a builder bid provider that has no payloads for its bids.
+type payloadlessProvider struct {
+	*mockbuilderbidprovider.Service
+}
+
+func (p *payloadlessProvider) ExecutionPayload(_ context.Context,
+	_ phase0.Slot,
+	_ phase0.Hash32,
+) (
+	*builderapi.VersionedSubmitBlindedBlockResponse,
+	error,
+) {
+	return nil, nil
+}
+
+func TestGetBuilderBidRecordPayload(t *testing.T) {
+	vars := map[string]string{
+		"slot":       "1",
+		"parenthash": "0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+		"pubkey":     "0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f",
+	}
+
+	tests := []struct {
+		name       string
+		provider   builderbidprovider.Service
+		unblinder  *recordingUnblinder
+		statusCode int
+		recorded   int
+	}{
+		{
+			name:     "Good",
+			provider: mockbuilderbidprovider.New(),
+			unblinder: &recordingUnblinder{
+				Service: mockblockunblinder.New(),
+			},
+			statusCode: http.StatusOK,
+			recorded:   1,
+		},
+		{
+			name: "PayloadMissing",
+			provider: &payloadlessProvider{
+				Service: mockbuilderbidprovider.New(),
+			},
+			unblinder: &recordingUnblinder{
+				Service: mockblockunblinder.New(),
+			},
+			statusCode: http.StatusNoContent,
+		},
+		{
+			name:     "RecordFails",
+			provider: mockbuilderbidprovider.New(),
+			unblinder: &recordingUnblinder{
+				Service: mockblockunblinder.New(),
+				err:     errors.New("error"),
+			},
+			statusCode: http.StatusNoContent,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				log:                zerolog.Nop(),
+				maxBidTimeout:      time.Second,
+				builderBidProvider: test.provider,
+				blockUnblinder:     test.unblinder,
+				payloadProvider:    test.provider.(builderbidprovider.ExecutionPayloadProvider),
+				payloadRecorder:    test.unblinder,
+			}
+
+			writer := httptest.NewRecorder()
+			s.getBuilderBid(writer, mux.SetURLVars(&http.Request{}, vars))
+			require.Equal(t, test.statusCode, writer.Result().StatusCode)
+			require.Len(t, test.unblinder.payloads, test.recorded)
+		})
+	}
+}
+
+func TestBidDeadline(t *testing.T) {
+	s := &Service{
+		maxBidTimeout: time.Second,
+	}
+
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		headers  map[string]string
+		expected time.Duration
+	}{
+		{
+			name:     "None",
+			expected: time.Second,
+		},
+		{
+			name: "Timeout",
+			headers: map[string]string{
+				TimeoutMs: "500",
+			},
+			expected: 500 * time.Millisecond,
+		},
+		{
+			name: "TimeoutCapped",
+			headers: map[string]string{
+				TimeoutMs: "5000",
+			},
+			expected: time.Second,
+		},
+		{
+			name: "TimeoutInvalid",
+			headers: map[string]string{
+				TimeoutMs: "soon",
+			},
+			expected: time.Second,
+		},
+		{
+			name: "TimeoutNegative",
+			headers: map[string]string{
+				TimeoutMs: "-100",
+			},
+			expected: time.Second,
+		},
+		{
+			name: "TimeoutWithDate",
+			headers: map[string]string{
+				TimeoutMs:        "500",
+				DateMilliseconds: strconv.FormatInt(now.Add(-200*time.Millisecond).UnixMilli(), 10),
+			},
+			expected: 300 * time.Millisecond,
+		},
+		{
+			name: "TimeoutWithDateExpired",
+			headers: map[string]string{
+				TimeoutMs:        "500",
+				DateMilliseconds: strconv.FormatInt(now.Add(-time.Second).UnixMilli(), 10),
+			},
+			expected: -500 * time.Millisecond,
+		},
+		{
+			name: "TimeoutWithDateFuture",
+			headers: map[string]string{
+				TimeoutMs:        "500",
+				DateMilliseconds: strconv.FormatInt(now.Add(time.Minute).UnixMilli(), 10),
+			},
+			expected: 500 * time.Millisecond,
+		},
+		{
+			name: "DateOnly",
+			headers: map[string]string{
+				DateMilliseconds: strconv.FormatInt(now.Add(-200*time.Millisecond).UnixMilli(), 10),
+			},
+			expected: time.Second,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &http.Request{
+				Header: http.Header{},
+			}
+			for k, v := range test.headers {
+				r.Header.Set(k, v)
+			}
+
+			deadline := s.bidDeadline(r)
+			require.WithinDuration(t, now.Add(test.expected), deadline, 50*time.Millisecond)
+		})
+	}
+}
+
+// slowAuctioneer is a block auctioneer that takes time to run its auction.
+type slowAuctioneer struct {
+	*bidAuctioneer
+	delay time.Duration
+}
+
+func (a *slowAuctioneer) AuctionBlock(ctx context.Context,
+	slot phase0.Slot,
+	parentHash phase0.Hash32,
+	pubkey phase0.BLSPubKey,
+) (
+	*blockauctioneer.Results,
+	error,
+) {
+	time.Sleep(a.delay)
+
+	return a.bidAuctioneer.AuctionBlock(ctx, slot, parentHash, pubkey)
+}
+
+func TestGetBuilderBidTimeout(t *testing.T) {
+	vars := map[string]string{
+		"slot":       "1",
+		"parenthash": "0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f",
+		"pubkey":     "0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f",
+	}
+
+	tests := []struct {
+		name       string
+		timeoutMs  string
+		statusCode int
+	}{
+		{
+			name:       "InTime",
+			timeoutMs:  "500",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "Late",
+			timeoutMs:  "50",
+			statusCode: http.StatusNoContent,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				log:           zerolog.Nop(),
+				maxBidTimeout: time.Second,
+				auctioneer: &slowAuctioneer{
+					bidAuctioneer: &bidAuctioneer{provider: mockbuilderbidprovider.New()},
+					delay:         200 * time.Millisecond,
+				},
+				builderBidProvider: mockbuilderbidprovider.New(),
+			}
+
+			request := mux.SetURLVars(&http.Request{
+				Header: http.Header{
+					TimeoutMs: []string{test.timeoutMs},
+				},
+			}, vars)
+			writer := httptest.NewRecorder()
+			started := time.Now()
+			s.getBuilderBid(writer, request)
+			require.Equal(t, test.statusCode, writer.Result().StatusCode)
+			if test.statusCode == http.StatusNoContent {
+				require.Less(t, time.Since(started), 150*time.Millisecond)
+			}
+		})
+	}
+}
+
+func TestMarshalBuilderBidSSZ(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{}
+
+	electraBid := &builderapielectra.SignedBuilderBid{
+		Message: &builderapielectra.BuilderBid{
+			Header: &deneb.ExecutionPayloadHeader{
+				BaseFeePerGas: uint256.NewInt(0),
+			},
+			ExecutionRequests: &electra.ExecutionRequests{},
+			Value:             uint256.NewInt(12345),
+		},
+	}
+	expected, err := electraBid.MarshalSSZ()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		bid  *spec.VersionedSignedBuilderBid
+		data []byte
+		err  string
+	}{
+		{
+			name: "Empty",
+			bid: &spec.VersionedSignedBuilderBid{
+				Version: consensusspec.DataVersionElectra,
+			},
+			err: "no data for version electra",
+		},
+		{
+			name: "Electra",
+			bid: &spec.VersionedSignedBuilderBid{
+				Version: consensusspec.DataVersionElectra,
+				Electra: electraBid,
+			},
+			data: expected,
+		},
+		{
+			name: "Fulu",
+			bid: &spec.VersionedSignedBuilderBid{
+				Version: consensusspec.DataVersionFulu,
+				Fulu:    electraBid,
+			},
+			data: expected,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := s.marshalBuilderBidSSZ(ctx, test.bid)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.data, data)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	relay "github.com/attestantio/go-block-relay"
+	"github.com/attestantio/go-block-relay/loggers"
+	builderapicapella "github.com/attestantio/go-builder-client/api/capella"
+	builderapideneb "github.com/attestantio/go-builder-client/api/deneb"
+	builderapielectra "github.com/attestantio/go-builder-client/api/electra"
+	builderapifulu "github.com/attestantio/go-builder-client/api/fulu"
+	builderspec "github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (s *Service) postBuilderBlock(w http.ResponseWriter, r *http.Request) {
+	log := loggers.WithRequestID(r.Context(), s.log)
+	log.Trace().Msg("postBuilderBlock called")
+
+	ctx := r.Context()
+
+	if s.blockSubmitter == nil {
+		log.Debug().Msg("No builder block submitter")
+		s.sendResponse(ctx, w,
+			http.StatusNotFound,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusNotFound,
+				Message: "Request not supported by service",
+			})
+		s.monitorRequestHandled("builder block", "failure")
+
+		return
+	}
+
+	err := s.decodeRequestBody(w, r, s.maxRequestBodySize)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to decode request body")
+		statusCode := requestBodyErrorStatus(err)
+		s.sendResponse(ctx, w,
+			statusCode,
+			map[string]string{},
+			&APIResponse{
+				Code:    statusCode,
+				Message: err.Error(),
+			})
+		s.monitorRequestHandled("builder block", "failure")
+
+		return
+	}
+
+	request, err := s.obtainSubmitBlockRequest(ctx, r)
+	if err != nil {
+		log.Debug().Err(err).Msg("Unable to obtain block submission")
+		statusCode := requestBodyErrorStatus(err)
+		s.sendResponse(ctx, w,
+			statusCode,
+			map[string]string{},
+			&APIResponse{
+				Code:    statusCode,
+				Message: err.Error(),
+			})
+		s.monitorRequestHandled("builder block", "failure")
+
+		return
+	}
+
+	err = s.blockSubmitter.SubmitBlock(ctx, request)
+	if err != nil {
+		if errors.Is(err, relay.ErrInvalidOptions) {
+			// Builders submit many blocks, so rejections are routine.
+			log.Debug().Err(err).Msg("Block submission rejected")
+			s.sendResponse(ctx, w,
+				http.StatusBadRequest,
+				map[string]string{},
+				&APIResponse{
+					Code:    http.StatusBadRequest,
+					Message: err.Error(),
+				})
+			s.monitorRequestHandled("builder block", "failure")
+
+			return
+		}
+
+		log.Error().Err(err).Msg("Failed to submit block")
+		s.sendResponse(ctx, w,
+			http.StatusInternalServerError,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusInternalServerError,
+				Message: "Failed to submit block",
+			})
+		s.monitorRequestHandled("builder block", "failure")
+
+		return
+	}
+
+	s.monitorRequestHandled("builder block", "success")
+	s.sendResponse(ctx, w,
+		http.StatusOK,
+		map[string]string{},
+		nil,
+	)
+}
+
+func (s *Service) obtainSubmitBlockRequest(ctx context.Context,
+	r *http.Request,
+) (
+	*builderspec.VersionedSubmitBlockRequest,
+	error,
+) {
+	contentType := s.obtainContentType(ctx, r)
+
+	// Obtain the consensus version so we know what we have to unmarshal to.
+	consensusVersion := r.Header.Get(EthConsensusVersion)
+	if consensusVersion == "" {
+		return nil, fmt.Errorf("no %s header provided", EthConsensusVersion)
+	}
+
+	request := &builderspec.VersionedSubmitBlockRequest{}
+
+	switch strings.ToLower(consensusVersion) {
+	case "capella":
+		request.Version = spec.DataVersionCapella
+		request.Capella = &builderapicapella.SubmitBlockRequest{}
+	case "deneb":
+		request.Version = spec.DataVersionDeneb
+		request.Deneb = &builderapideneb.SubmitBlockRequest{}
+	case "electra":
+		request.Version = spec.DataVersionElectra
+		request.Electra = &builderapielectra.SubmitBlockRequest{}
+	case "fulu":
+		request.Version = spec.DataVersionFulu
+		request.Fulu = &builderapifulu.SubmitBlockRequest{}
+	default:
+		return nil, fmt.Errorf("unsupported block version %v", consensusVersion)
+	}
+
+	var err error
+
+	switch strings.ToLower(contentType) {
+	case contentTypeSSZ:
+		err = s.unmarshalSubmitBlockRequestSSZ(request, r.Body)
+	case contentTypeJSON:
+		err = s.unmarshalSubmitBlockRequestJSON(request, r.Body)
+	default:
+		return nil, fmt.Errorf("unsupported content type %s", contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	slot, err := request.Slot()
+	if err == nil {
+		trace.SpanFromContext(ctx).SetAttributes(attribute.Int64("slot", int64(slot)))
+	}
+
+	return request, nil
+}
+
+func (*Service) unmarshalSubmitBlockRequestJSON(request *builderspec.VersionedSubmitBlockRequest,
+	body io.Reader,
+) error {
+	var err error
+
+	switch request.Version {
+	case spec.DataVersionCapella:
+		err = json.NewDecoder(body).Decode(request.Capella)
+	case spec.DataVersionDeneb:
+		err = json.NewDecoder(body).Decode(request.Deneb)
+	case spec.DataVersionElectra:
+		err = json.NewDecoder(body).Decode(request.Electra)
+	case spec.DataVersionFulu:
+		err = json.NewDecoder(body).Decode(request.Fulu)
+	default:
+		err = fmt.Errorf("unsupported block version %v", request.Version)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return nil
+}
+
+func (*Service) unmarshalSubmitBlockRequestSSZ(request *builderspec.VersionedSubmitBlockRequest,
+	body io.Reader,
+) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read body")
+	}
+
+	switch request.Version {
+	case spec.DataVersionCapella:
+		err = request.Capella.UnmarshalSSZ(data)
+	case spec.DataVersionDeneb:
+		err = request.Deneb.UnmarshalSSZ(data)
+	case spec.DataVersionElectra:
+		err = request.Electra.UnmarshalSSZ(data)
+	case spec.DataVersionFulu:
+		err = request.Fulu.UnmarshalSSZ(data)
+	default:
+		err = fmt.Errorf("unsupported block version %v", request.Version)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "invalid SSZ")
+	}
+
+	return nil
+}
@@ -0,0 +1,440 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	relay "github.com/attestantio/go-block-relay"
+	"github.com/attestantio/go-block-relay/services/bidtracerecorder"
+	standardbidtracerecorder "github.com/attestantio/go-block-relay/services/bidtracerecorder/standard"
+	standardblockunblinder "github.com/attestantio/go-block-relay/services/blockunblinder/standard"
+	"github.com/attestantio/go-block-relay/services/builderbidprovider/multi"
+	"github.com/attestantio/go-block-relay/services/builderblocksubmitter"
+	mockbuilderblocksubmitter "github.com/attestantio/go-block-relay/services/builderblocksubmitter/mock"
+	standardbuilderblocksubmitter "github.com/attestantio/go-block-relay/services/builderblocksubmitter/standard"
+	mockgaslimitprovider "github.com/attestantio/go-block-relay/services/gaslimitprovider/mock"
+	nullmetrics "github.com/attestantio/go-block-relay/services/metrics/null"
+	mockproposerdutiesprovider "github.com/attestantio/go-block-relay/services/proposerdutiesprovider/mock"
+	mockvalidatorregistrar "github.com/attestantio/go-block-relay/services/validatorregistrar/mock"
+	"github.com/attestantio/go-block-relay/signing"
+	"github.com/attestantio/go-block-relay/testing/builder"
+	"github.com/attestantio/go-block-relay/types"
+	builderclient "github.com/attestantio/go-builder-client"
+	builderapideneb "github.com/attestantio/go-builder-client/api/deneb"
+	builderapielectra "github.com/attestantio/go-builder-client/api/electra"
+	apiv1 "github.com/attestantio/go-builder-client/api/v1"
+	builderspec "github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/go-eth2-client/api"
+	consensusapiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	consensusspec "github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/capella"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/gorilla/mux"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// blockSubmitter records the submissions it receives.
+type blockSubmitter struct {
+	request *builderspec.VersionedSubmitBlockRequest
+	err     error
+}
+
+func (b *blockSubmitter) SubmitBlock(_ context.Context,
+	request *builderspec.VersionedSubmitBlockRequest,
+) error {
+	b.request = request
+
+	return b.err
+}
+
+// testSubmitBlockRequest creates an electra block submission.
+func testSubmitBlockRequest() *builderapielectra.SubmitBlockRequest {
+	return &builderapielectra.SubmitBlockRequest{
+		Message: &apiv1.BidTrace{
+			Slot:      10,
+			BlockHash: phase0.Hash32{0x01},
+			Value:     uint256.NewInt(1),
+		},
+		ExecutionPayload: &deneb.ExecutionPayload{
+			BaseFeePerGas: uint256.NewInt(0),
+			BlockHash:     phase0.Hash32{0x01},
+		},
+		BlobsBundle: &builderapideneb.BlobsBundle{
+			Commitments: make([]deneb.KZGCommitment, 0),
+			Proofs:      make([]deneb.KZGProof, 0),
+			Blobs:       make([]deneb.Blob, 0),
+		},
+		ExecutionRequests: &electra.ExecutionRequests{},
+	}
+}
+
+func TestPostBuilderBlock(t *testing.T) {
+	jsonBody, err := json.Marshal(testSubmitBlockRequest())
+	require.NoError(t, err)
+	sszBody, err := testSubmitBlockRequest().MarshalSSZ()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		submitter   builderblocksubmitter.Service
+		headers     map[string]string
+		body        []byte
+		maxBodySize int64
+		statusCode  int
+		message     string
+		submitted   bool
+	}{
+		{
+			name:       "Unsupported",
+			headers:    map[string]string{EthConsensusVersion: "electra"},
+			body:       jsonBody,
+			statusCode: http.StatusNotFound,
+			message:    "Request not supported by service",
+		},
+		{
+			name:       "VersionMissing",
+			submitter:  &blockSubmitter{},
+			body:       jsonBody,
+			statusCode: http.StatusBadRequest,
+			message:    "no Eth-Consensus-Version header provided",
+		},
+		{
+			name:       "VersionUnsupported",
+			submitter:  &blockSubmitter{},
+			headers:    map[string]string{EthConsensusVersion: "bellatrix"},
+			body:       jsonBody,
+			statusCode: http.StatusBadRequest,
+			message:    "unsupported block version bellatrix",
+		},
+		{
+			name:       "ContentTypeUnsupported",
+			submitter:  &blockSubmitter{},
+			headers:    map[string]string{EthConsensusVersion: "electra", "Content-Type": "text/plain"},
+			body:       jsonBody,
+			statusCode: http.StatusBadRequest,
+			message:    "unsupported content type text/plain",
+		},
+		{
+			name:       "JSONInvalid",
+			submitter:  &blockSubmitter{},
+			headers:    map[string]string{EthConsensusVersion: "electra"},
+			body:       []byte("{"),
+			statusCode: http.StatusBadRequest,
+			message:    "invalid JSON: unexpected EOF",
+		},
+		{
+			name:       "SSZInvalid",
+			submitter:  &blockSubmitter{},
+			headers:    map[string]string{EthConsensusVersion: "electra", "Content-Type": contentTypeSSZ},
+			body:       []byte{0x01},
+			statusCode: http.StatusBadRequest,
+			message:    "invalid SSZ: incorrect size",
+		},
+		{
+			name:       "Rejected",
+			submitter:  &blockSubmitter{err: errors.Wrap(relay.ErrInvalidOptions, "invalid signature")},
+			headers:    map[string]string{EthConsensusVersion: "electra"},
+			body:       jsonBody,
+			statusCode: http.StatusBadRequest,
+			message:    "invalid signature: invalid options",
+			submitted:  true,
+		},
+		{
+			name:       "Erroring",
+			submitter:  mockbuilderblocksubmitter.NewErroring(),
+			headers:    map[string]string{EthConsensusVersion: "electra"},
+			body:       jsonBody,
+			statusCode: http.StatusInternalServerError,
+			message:    "Failed to submit block",
+		},
+		{
+			name:        "TooLarge",
+			submitter:   &blockSubmitter{},
+			headers:     map[string]string{EthConsensusVersion: "electra", "Content-Type": contentTypeJSON},
+			body:        jsonBody,
+			maxBodySize: 16,
+			statusCode:  http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:       "JSON",
+			submitter:  &blockSubmitter{},
+			headers:    map[string]string{EthConsensusVersion: "electra", "Content-Type": contentTypeJSON},
+			body:       jsonBody,
+			statusCode: http.StatusOK,
+			submitted:  true,
+		},
+		{
+			name:       "SSZ",
+			submitter:  &blockSubmitter{},
+			headers:    map[string]string{EthConsensusVersion: "electra", "Content-Type": contentTypeSSZ},
+			body:       sszBody,
+			statusCode: http.StatusOK,
+			submitted:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			maxBodySize := test.maxBodySize
+			if maxBodySize == 0 {
+				maxBodySize = 64 * 1024 * 1024
+			}
+
+			s := &Service{
+				log:                zerolog.Nop(),
+				maxRequestBodySize: maxBodySize,
+				blockSubmitter:     test.submitter,
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/relay/v1/builder/blocks", bytes.NewReader(test.body))
+			for k, v := range test.headers {
+				req.Header.Set(k, v)
+			}
+			writer := httptest.NewRecorder()
+			s.postBuilderBlock(writer, req)
+			require.Equal(t, test.statusCode, writer.Code)
+
+			if test.message != "" {
+				resp := &APIResponse{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+				require.Equal(t, test.message, resp.Message)
+			}
+
+			if test.submitted {
+				request := test.submitter.(*blockSubmitter).request
+				require.NotNil(t, request)
+				require.Equal(t, consensusspec.DataVersionElectra, request.Version)
+				slot, err := request.Slot()
+				require.NoError(t, err)
+				require.Equal(t, uint64(10), slot)
+			}
+		})
+	}
+}
+
+// fixedRegistration provides a single validator registration.
+type fixedRegistration struct {
+	registration *types.SignedValidatorRegistration
+}
+
+func (f *fixedRegistration) ValidatorRegistration(_ context.Context,
+	pubkey phase0.BLSPubKey,
+) (
+	*types.SignedValidatorRegistration,
+	error,
+) {
+	if pubkey != f.registration.Message.Pubkey {
+		return nil, nil
+	}
+
+	return f.registration, nil
+}
+
+func (f *fixedRegistration) AllValidatorRegistrations(_ context.Context) ([]*types.SignedValidatorRegistration, error) {
+	return []*types.SignedValidatorRegistration{f.registration}, nil
+}
+
+func TestPostBuilderBlockServed(t *testing.T) {
+	ctx := context.Background()
+
+	parentHash := phase0.Hash32{0x01}
+	proposerSecretKey := builder.SecretKey(0x03)
+	proposerPubkey, err := signing.PublicKey(proposerSecretKey)
+	require.NoError(t, err)
+	feeRecipient := bellatrix.ExecutionAddress{0x04}
+	builderSecretKey := builder.SecretKey(0x02)
+	builderPubkey, err := signing.PublicKey(builderSecretKey)
+	require.NoError(t, err)
+
+	submitter, err := standardbuilderblocksubmitter.New(ctx,
+		standardbuilderblocksubmitter.WithLogLevel(zerolog.Disabled),
+		// Slot 10 is the current slot.
+		standardbuilderblocksubmitter.WithGenesisTime(time.Now().Add(-10*12*time.Second-time.Second)),
+		standardbuilderblocksubmitter.WithSecretKey(builder.SecretKey(0x01)),
+		standardbuilderblocksubmitter.WithValidatorRegistrationProvider(&fixedRegistration{
+			registration: &types.SignedValidatorRegistration{
+				Message: &types.ValidatorRegistration{
+					FeeRecipient: feeRecipient,
+					GasLimit:     30000000,
+					Pubkey:       proposerPubkey,
+				},
+			},
+		}),
+		standardbuilderblocksubmitter.WithParentGasLimitProvider(mockgaslimitprovider.New(30000000)),
+	)
+	require.NoError(t, err)
+
+	providers, err := multi.New(ctx,
+		multi.WithLogLevel(zerolog.Disabled),
+		multi.WithBuilderBidProviders([]builderclient.BuilderBidProvider{submitter.UpstreamProvider()}),
+	)
+	require.NoError(t, err)
+
+	forkVersions := map[consensusspec.DataVersion]phase0.Version{
+		consensusspec.DataVersionElectra: {0x05},
+	}
+	genesisValidatorsRoot := phase0.Root{0x01}
+	unblinder, err := standardblockunblinder.New(ctx,
+		standardblockunblinder.WithLogLevel(zerolog.Disabled),
+		standardblockunblinder.WithProposerDutiesProvider(mockproposerdutiesprovider.New(&consensusapiv1.ProposerDuty{
+			PubKey:         proposerPubkey,
+			Slot:           10,
+			ValidatorIndex: 5,
+		})),
+		standardblockunblinder.WithForkVersions(forkVersions),
+		standardblockunblinder.WithGenesisValidatorsRoot(genesisValidatorsRoot),
+	)
+	require.NoError(t, err)
+
+	recorder, err := standardbidtracerecorder.New(ctx,
+		standardbidtracerecorder.WithLogLevel(zerolog.Disabled),
+	)
+	require.NoError(t, err)
+
+	s, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithMonitor(nullmetrics.New()),
+		WithListenAddress(":14741"),
+		WithValidatorRegistrar(mockvalidatorregistrar.New()),
+		WithBlockAuctioneer(providers),
+		WithBlockUnblinder(unblinder),
+		WithBuilderBidProvider(providers),
+		WithBuilderBlockSubmitter(submitter),
+		WithBidTraceRecorder(recorder),
+	)
+	require.NoError(t, err)
+
+	// Submit a block from the builder.
+	bidTrace := &apiv1.BidTrace{
+		Slot:                 10,
+		ParentHash:           parentHash,
+		BlockHash:            phase0.Hash32{0x10},
+		BuilderPubkey:        builderPubkey,
+		ProposerPubkey:       proposerPubkey,
+		ProposerFeeRecipient: feeRecipient,
+		GasLimit:             30000000,
+		GasUsed:              21000,
+		Value:                uint256.NewInt(1000),
+	}
+	domain, err := signing.ComputeBuilderDomain(phase0.Version{})
+	require.NoError(t, err)
+	root, err := bidTrace.HashTreeRoot()
+	require.NoError(t, err)
+	signingRoot, err := signing.ComputeSigningRoot(root, domain)
+	require.NoError(t, err)
+	signature, err := signing.Sign(builderSecretKey, signingRoot)
+	require.NoError(t, err)
+
+	body, err := json.Marshal(&builderapielectra.SubmitBlockRequest{
+		Message: bidTrace,
+		ExecutionPayload: &deneb.ExecutionPayload{
+			ParentHash:    parentHash,
+			FeeRecipient:  feeRecipient,
+			GasLimit:      bidTrace.GasLimit,
+			GasUsed:       bidTrace.GasUsed,
+			BaseFeePerGas: uint256.NewInt(7),
+			BlockHash:     bidTrace.BlockHash,
+			Transactions:  make([]bellatrix.Transaction, 0),
+			Withdrawals:   make([]*capella.Withdrawal, 0),
+		},
+		BlobsBundle: &builderapideneb.BlobsBundle{
+			Commitments: make([]deneb.KZGCommitment, 0),
+			Proofs:      make([]deneb.KZGProof, 0),
+			Blobs:       make([]deneb.Blob, 0),
+		},
+		ExecutionRequests: &electra.ExecutionRequests{},
+		Signature:         signature,
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/relay/v1/builder/blocks", bytes.NewReader(body))
+	req.Header.Set(EthConsensusVersion, "electra")
+	writer := httptest.NewRecorder()
+	s.postBuilderBlock(writer, req)
+	require.Equal(t, http.StatusOK, writer.Code)
+
+	// The submitted block is served as the proposer's header.
+	writer = httptest.NewRecorder()
+	s.getBuilderBid(writer, mux.SetURLVars(&http.Request{}, map[string]string{
+		"slot":       "10",
+		"parenthash": parentHash.String(),
+		"pubkey":     proposerPubkey.String(),
+	}))
+	require.Equal(t, http.StatusOK, writer.Code)
+
+	resp := &struct {
+		Data *builderapielectra.SignedBuilderBid `json:"data"`
+	}{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	header := resp.Data.Message.Header
+	require.Equal(t, bidTrace.BlockHash, header.BlockHash)
+
+	// The payload behind the bid was recorded, so the proposer's signed block can be unblinded.
+	blindedBlock := &apiv1electra.BlindedBeaconBlock{
+		Slot:          10,
+		ProposerIndex: 5,
+		Body: &apiv1electra.BlindedBeaconBlockBody{
+			ETH1Data: &phase0.ETH1Data{BlockHash: make([]byte, 32)},
+			SyncAggregate: &altair.SyncAggregate{
+				SyncCommitteeBits: bitfield.NewBitvector512(),
+			},
+			ExecutionPayloadHeader: header,
+			BlobKZGCommitments:     resp.Data.Message.BlobKZGCommitments,
+			ExecutionRequests:      &electra.ExecutionRequests{},
+		},
+	}
+	proposerDomain, err := signing.ComputeDomain(signing.DomainBeaconProposer,
+		forkVersions[consensusspec.DataVersionElectra],
+		genesisValidatorsRoot,
+	)
+	require.NoError(t, err)
+	root, err = blindedBlock.HashTreeRoot()
+	require.NoError(t, err)
+	signingRoot, err = signing.ComputeSigningRoot(root, proposerDomain)
+	require.NoError(t, err)
+	signature, err = signing.Sign(proposerSecretKey, signingRoot)
+	require.NoError(t, err)
+
+	proposal, err := unblinder.UnblindBlock(ctx, &api.VersionedSignedBlindedBeaconBlock{
+		Version: consensusspec.DataVersionElectra,
+		Electra: &apiv1electra.SignedBlindedBeaconBlock{
+			Message:   blindedBlock,
+			Signature: signature,
+		},
+	})
+	require.NoError(t, err)
+
+	// The served bid is signed by the relay, but traced with the builder that submitted it.
+	require.NoError(t, recorder.RecordDeliveredPayload(ctx, proposal))
+	traces, err := recorder.DeliveredPayloads(ctx, &bidtracerecorder.DeliveredPayloadsFilter{})
+	require.NoError(t, err)
+	require.Len(t, traces, 1)
+	require.Equal(t, builderPubkey, traces[0].BuilderPubkey)
+}
@@ -0,0 +1,114 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/attestantio/go-block-relay/loggers"
+	"github.com/attestantio/go-block-relay/services/validatorregistrar"
+	"github.com/attestantio/go-block-relay/types"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// builderValidatorJSON is the relay API representation of a registered upcoming proposer.
+type builderValidatorJSON struct {
+	Slot           string                             `json:"slot"`
+	ValidatorIndex string                             `json:"validator_index"`
+	Entry          *types.SignedValidatorRegistration `json:"entry"`
+}
+
+func (s *Service) getBuilderValidators(w http.ResponseWriter, r *http.Request) {
+	log := loggers.WithRequestID(r.Context(), s.log)
+	log.Trace().Msg("getBuilderValidators called")
+
+	provider, isProvider := s.validatorRegistrar.(validatorregistrar.ValidatorRegistrationProvider)
+	if !isProvider || s.dutiesProvider == nil {
+		log.Debug().Msg("Registrations or proposer duties not available")
+		s.sendResponse(r.Context(), w,
+			http.StatusNotFound,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusNotFound,
+				Message: "Request not supported by service",
+			})
+		s.monitorRequestHandled("builder validators", "failure")
+
+		return
+	}
+
+	validators, err := s.builderValidators(r.Context(), provider)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to obtain builder validators")
+		s.sendResponse(r.Context(), w,
+			http.StatusInternalServerError,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusInternalServerError,
+				Message: "Failed to obtain validators",
+			})
+		s.monitorRequestHandled("builder validators", "failure")
+
+		return
+	}
+
+	s.monitorRequestHandled("builder validators", "success")
+	s.sendResponse(r.Context(), w,
+		http.StatusOK,
+		map[string]string{},
+		validators,
+	)
+}
+
+// builderValidators joins proposer duties with validator registrations, in slot order.
+// Proposers without a registration are omitted, as builders cannot build blocks for them.
+func (s *Service) builderValidators(ctx context.Context,
+	provider validatorregistrar.ValidatorRegistrationProvider,
+) (
+	[]*builderValidatorJSON,
+	error,
+) {
+	duties, err := s.dutiesProvider.ProposerDuties(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sort a copy, as the duties may be shared with the provider.
+	duties = append([]*apiv1.ProposerDuty{}, duties...)
+	sort.Slice(duties, func(i, j int) bool {
+		return duties[i].Slot < duties[j].Slot
+	})
+
+	validators := make([]*builderValidatorJSON, 0, len(duties))
+	for _, duty := range duties {
+		registration, err := provider.ValidatorRegistration(ctx, duty.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		if registration == nil {
+			continue
+		}
+
+		validators = append(validators, &builderValidatorJSON{
+			Slot:           fmt.Sprintf("%d", duty.Slot),
+			ValidatorIndex: fmt.Sprintf("%d", duty.ValidatorIndex),
+			Entry:          registration,
+		})
+	}
+
+	return validators, nil
+}
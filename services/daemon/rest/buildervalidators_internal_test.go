@@ -0,0 +1,122 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-block-relay/services/proposerdutiesprovider"
+	mockproposerdutiesprovider "github.com/attestantio/go-block-relay/services/proposerdutiesprovider/mock"
+	"github.com/attestantio/go-block-relay/services/validatorregistrar"
+	mockvalidatorregistrar "github.com/attestantio/go-block-relay/services/validatorregistrar/mock"
+	"github.com/attestantio/go-block-relay/types"
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBuilderValidators(t *testing.T) {
+	provider := &registrationProvider{
+		registrations: map[phase0.BLSPubKey]*types.SignedValidatorRegistration{
+			{0x01}: {
+				Message: &types.ValidatorRegistration{
+					FeeRecipient: bellatrix.ExecutionAddress{0x02},
+					GasLimit:     30000000,
+					Timestamp:    time.Unix(1700000000, 0),
+					Pubkey:       phase0.BLSPubKey{0x01},
+				},
+				Signature: phase0.BLSSignature{0x03},
+			},
+			{0x02}: {
+				Message: &types.ValidatorRegistration{
+					FeeRecipient: bellatrix.ExecutionAddress{0x04},
+					GasLimit:     36000000,
+					Timestamp:    time.Unix(1700000000, 0),
+					Pubkey:       phase0.BLSPubKey{0x02},
+				},
+				Signature: phase0.BLSSignature{0x05},
+			},
+		},
+	}
+
+	duties := mockproposerdutiesprovider.New(
+		&apiv1.ProposerDuty{PubKey: phase0.BLSPubKey{0x02}, Slot: 12, ValidatorIndex: 2},
+		// Unregistered proposer.
+		&apiv1.ProposerDuty{PubKey: phase0.BLSPubKey{0x03}, Slot: 11, ValidatorIndex: 3},
+		&apiv1.ProposerDuty{PubKey: phase0.BLSPubKey{0x01}, Slot: 10, ValidatorIndex: 1},
+	)
+
+	tests := []struct {
+		name           string
+		registrar      validatorregistrar.Service
+		dutiesProvider proposerdutiesprovider.Service
+		statusCode     int
+		body           string
+	}{
+		{
+			name:           "RegistrationsUnsupported",
+			registrar:      mockvalidatorregistrar.New(),
+			dutiesProvider: duties,
+			statusCode:     http.StatusNotFound,
+			body:           `{"code":404,"message":"Request not supported by service"}`,
+		},
+		{
+			name:       "DutiesUnsupported",
+			registrar:  provider,
+			statusCode: http.StatusNotFound,
+			body:       `{"code":404,"message":"Request not supported by service"}`,
+		},
+		{
+			name:           "DutiesErroring",
+			registrar:      provider,
+			dutiesProvider: mockproposerdutiesprovider.NewErroring(),
+			statusCode:     http.StatusInternalServerError,
+			body:           `{"code":500,"message":"Failed to obtain validators"}`,
+		},
+		{
+			name:           "NoDuties",
+			registrar:      provider,
+			dutiesProvider: mockproposerdutiesprovider.New(),
+			statusCode:     http.StatusOK,
+			body:           `[]`,
+		},
+		{
+			name:           "Good",
+			registrar:      provider,
+			dutiesProvider: duties,
+			statusCode:     http.StatusOK,
+			body:           `[{"slot":"10","validator_index":"1","entry":{"message":{"fee_recipient":"0x0200000000000000000000000000000000000000","gas_limit":"30000000","timestamp":"1700000000","pubkey":"0x010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},"signature":"0x030000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}},{"slot":"12","validator_index":"2","entry":{"message":{"fee_recipient":"0x0400000000000000000000000000000000000000","gas_limit":"36000000","timestamp":"1700000000","pubkey":"0x020000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},"signature":"0x050000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}}]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				log:                zerolog.Nop(),
+				validatorRegistrar: test.registrar,
+				dutiesProvider:     test.dutiesProvider,
+			}
+
+			writer := httptest.NewRecorder()
+			s.getBuilderValidators(writer, httptest.NewRequest(http.MethodGet, "/relay/v1/builder/validators", nil))
+			require.Equal(t, test.statusCode, writer.Code)
+			require.JSONEq(t, test.body, writer.Body.String())
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"crypto/tls"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// certificateStore holds a server certificate loaded from disk,
+// allowing it to be replaced without restarting the server.
+type certificateStore struct {
+	certFile string
+	keyFile  string
+
+	mu          sync.RWMutex
+	certificate *tls.Certificate
+}
+
+// newCertificateStore creates a certificate store, loading the initial certificate.
+func newCertificateStore(certFile string, keyFile string) (*certificateStore, error) {
+	c := &certificateStore{
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+
+	err := c.reload()
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// reload reloads the certificate from disk.
+// If the certificate cannot be loaded the existing certificate is retained.
+func (c *certificateStore) reload() error {
+	certificate, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		return errors.Wrap(err, "failed to load server certificate")
+	}
+
+	c.mu.Lock()
+	c.certificate = &certificate
+	c.mu.Unlock()
+
+	return nil
+}
+
+// getCertificate provides the current certificate, for use in a TLS configuration.
+func (c *certificateStore) getCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.certificate, nil
+}
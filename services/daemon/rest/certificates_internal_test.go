@@ -0,0 +1,141 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	mockauctioneer "github.com/attestantio/go-block-relay/services/blockauctioneer/mock"
+	mockblockunblinder "github.com/attestantio/go-block-relay/services/blockunblinder/mock"
+	mockbuilderbidprovider "github.com/attestantio/go-block-relay/services/builderbidprovider/mock"
+	nullmetrics "github.com/attestantio/go-block-relay/services/metrics/null"
+	mockvalidatorregistrar "github.com/attestantio/go-block-relay/services/validatorregistrar/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCertificate writes a self-signed certificate and key with the given serial number.
+func writeCertificate(t *testing.T, certFile string, keyFile string, serial int64) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+}
+
+func TestCertificateStore(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+
+	_, err := newCertificateStore(certFile, keyFile)
+	require.ErrorContains(t, err, "failed to load server certificate")
+
+	writeCertificate(t, certFile, keyFile, 1)
+	store, err := newCertificateStore(certFile, keyFile)
+	require.NoError(t, err)
+
+	certificate, err := store.getCertificate(nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), certificate.Leaf.SerialNumber.Int64())
+
+	// Reload with a new certificate.
+	writeCertificate(t, certFile, keyFile, 2)
+	require.NoError(t, store.reload())
+	certificate, err = store.getCertificate(nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), certificate.Leaf.SerialNumber.Int64())
+
+	// Ensure a bad reload retains the existing certificate.
+	require.NoError(t, os.WriteFile(keyFile, []byte("bad"), 0o600))
+	require.Error(t, store.reload())
+	certificate, err = store.getCertificate(nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), certificate.Leaf.SerialNumber.Int64())
+}
+
+func TestTLSServer(t *testing.T) {
+	ctx := context.Background()
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	writeCertificate(t, certFile, keyFile, 1)
+
+	_, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithMonitor(nullmetrics.New()),
+		WithListenAddress("127.0.0.1:14740"),
+		WithServerCertFile(certFile),
+		WithServerKeyFile(keyFile),
+		WithValidatorRegistrar(mockvalidatorregistrar.New()),
+		WithBlockAuctioneer(mockauctioneer.New()),
+		WithBlockUnblinder(mockblockunblinder.New()),
+		WithBuilderBidProvider(mockbuilderbidprovider.New()),
+	)
+	require.NoError(t, err)
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				//nolint:gosec
+				InsecureSkipVerify: true,
+			},
+		},
+	}
+
+	var resp *http.Response
+	require.Eventually(t, func() bool {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://127.0.0.1:14740/eth/v1/builder/status", nil)
+		require.NoError(t, err)
+
+		resp, err = client.Do(req)
+
+		return err == nil
+	}, 2*time.Second, 10*time.Millisecond)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NotNil(t, resp.TLS)
+	require.Equal(t, int64(1), resp.TLS.PeerCertificates[0].SerialNumber.Int64())
+}
@@ -0,0 +1,204 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+)
+
+const (
+	encodingGzip = "gzip"
+	encodingZstd = "zstd"
+)
+
+// minCompressedResponseSize is the smallest response that is compressed; below this
+// the overhead of compression outweighs the saving.
+const minCompressedResponseSize = 1024
+
+// errUnsupportedContentEncoding is returned when a request body uses an unsupported encoding.
+var errUnsupportedContentEncoding = errors.New("unsupported content encoding")
+
+// zstdEncoder is shared between responses, as its EncodeAll function is safe for concurrent use.
+var zstdEncoder = sync.OnceValues(func() (*zstd.Encoder, error) {
+	return zstd.NewWriter(nil)
+})
+
+type responseEncodingContextKey struct{}
+
+// selectResponseEncoding selects the encoding for the response from the request's
+// Accept-Encoding header, and makes it available to the request's handler.
+func (*Service) selectResponseEncoding(c *gin.Context) {
+	c.Header("Vary", "Accept-Encoding")
+
+	encoding := acceptedEncoding(c.GetHeader("Accept-Encoding"))
+	if encoding != "" {
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), responseEncodingContextKey{}, encoding))
+	}
+
+	c.Next()
+}
+
+// acceptedEncoding selects a supported encoding from the value of an Accept-Encoding header.
+// It returns an empty string if the response should not be encoded.
+func acceptedEncoding(header string) string {
+	if strings.TrimSpace(header) == "" {
+		return ""
+	}
+
+	encodings := parseAccept(header)
+
+	refused := make(map[string]bool)
+	for _, encoding := range encodings {
+		if encoding.quality <= 0 {
+			refused[encoding.mediaType] = true
+		}
+	}
+
+	for _, encoding := range encodings {
+		if encoding.quality <= 0 {
+			// All following encodings are also unacceptable.
+			break
+		}
+
+		switch encoding.mediaType {
+		case encodingGzip, encodingZstd:
+			return encoding.mediaType
+		case "*":
+			for _, supported := range []string{encodingGzip, encodingZstd} {
+				if !refused[supported] {
+					return supported
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// responseEncoding provides the encoding selected for the response, if any.
+func responseEncoding(ctx context.Context) string {
+	encoding, ok := ctx.Value(responseEncodingContextKey{}).(string)
+	if !ok {
+		return ""
+	}
+
+	return encoding
+}
+
+// encodeResponse encodes response data with the given encoding.
+func encodeResponse(encoding string, data []byte) ([]byte, error) {
+	switch encoding {
+	case encodingGzip:
+		buf := new(bytes.Buffer)
+		writer := gzip.NewWriter(buf)
+		if _, err := writer.Write(data); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	case encodingZstd:
+		encoder, err := zstdEncoder()
+		if err != nil {
+			return nil, err
+		}
+
+		return encoder.EncodeAll(data, make([]byte, 0, len(data)/2)), nil
+	default:
+		return nil, fmt.Errorf("unsupported encoding %s", encoding)
+	}
+}
+
+// decodeRequestBody replaces the request's body with one that is decoded according to its
+// Content-Encoding header.  The limit applies to the decoded body, so that a small compressed
+// body cannot expand without bound.
+func (s *Service) decodeRequestBody(w http.ResponseWriter, r *http.Request, limit int64) error {
+	encoding := strings.ToLower(strings.TrimSpace(r.Header.Get("Content-Encoding")))
+
+	var body io.ReadCloser
+
+	switch encoding {
+	case "", "identity":
+		body = r.Body
+	case encodingGzip:
+		reader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return errors.Wrap(err, "invalid gzip body")
+		}
+		body = reader
+	case encodingZstd:
+		decoder, err := zstd.NewReader(r.Body,
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderMaxMemory(uint64(limit)),
+		)
+		if err != nil {
+			return errors.Wrap(err, "invalid zstd body")
+		}
+		body = &zstdBody{
+			ReadCloser: decoder.IOReadCloser(),
+			limit:      limit,
+		}
+	default:
+		return fmt.Errorf("%w %s", errUnsupportedContentEncoding, encoding)
+	}
+
+	r.Body = http.MaxBytesReader(w, body, limit)
+	r.Header.Del("Content-Encoding")
+
+	return nil
+}
+
+// zstdBody reports a frame that declares a size beyond the decoder's limit in
+// the same way as any other oversized body.
+type zstdBody struct {
+	io.ReadCloser
+
+	limit int64
+}
+
+func (b *zstdBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if errors.Is(err, zstd.ErrDecoderSizeExceeded) {
+		return n, &http.MaxBytesError{Limit: b.limit}
+	}
+
+	return n, err
+}
+
+// requestBodyErrorStatus provides the status code for an error obtaining a request body.
+func requestBodyErrorStatus(err error) int {
+	var maxBytesErr *http.MaxBytesError
+
+	switch {
+	case errors.As(err, &maxBytesErr), errors.Is(err, errTooManyRegistrations):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, errUnsupportedContentEncoding):
+		return http.StatusUnsupportedMediaType
+	default:
+		return http.StatusBadRequest
+	}
+}
@@ -0,0 +1,356 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockvalidatorregistrar "github.com/attestantio/go-block-relay/services/validatorregistrar/mock"
+	"github.com/klauspost/compress/zstd"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func gzipData(t *testing.T, data []byte) []byte {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	writer := gzip.NewWriter(buf)
+	_, err := writer.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	return buf.Bytes()
+}
+
+func zstdData(t *testing.T, data []byte) []byte {
+	t.Helper()
+
+	encoder, err := zstd.NewWriter(nil)
+	require.NoError(t, err)
+	defer encoder.Close()
+
+	return encoder.EncodeAll(data, nil)
+}
+
+func TestAcceptedEncoding(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		encoding string
+	}{
+		{
+			name: "Empty",
+		},
+		{
+			name:     "Gzip",
+			header:   "gzip",
+			encoding: "gzip",
+		},
+		{
+			name:     "Zstd",
+			header:   "zstd",
+			encoding: "zstd",
+		},
+		{
+			name:     "ClientOrder",
+			header:   "zstd, gzip",
+			encoding: "zstd",
+		},
+		{
+			name:     "Quality",
+			header:   "zstd;q=0.5, gzip",
+			encoding: "gzip",
+		},
+		{
+			name:   "Unsupported",
+			header: "br, deflate",
+		},
+		{
+			name:   "Identity",
+			header: "identity",
+		},
+		{
+			name:     "Wildcard",
+			header:   "*",
+			encoding: "gzip",
+		},
+		{
+			name:     "WildcardRefused",
+			header:   "gzip;q=0, *",
+			encoding: "zstd",
+		},
+		{
+			name:   "Refused",
+			header: "gzip;q=0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.encoding, acceptedEncoding(test.header))
+		})
+	}
+}
+
+func TestDecodeRequestBody(t *testing.T) {
+	data := bytes.Repeat([]byte("registration"), 100)
+
+	tests := []struct {
+		name       string
+		encoding   string
+		body       []byte
+		maxSize    int64
+		err        string
+		readErr    string
+		statusCode int
+	}{
+		{
+			name:    "Identity",
+			body:    data,
+			maxSize: 2048,
+		},
+		{
+			name:     "IdentityExplicit",
+			encoding: "identity",
+			body:     data,
+			maxSize:  2048,
+		},
+		{
+			name:     "Gzip",
+			encoding: "gzip",
+			body:     gzipData(t, data),
+			maxSize:  2048,
+		},
+		{
+			name:     "Zstd",
+			encoding: "ZSTD",
+			body:     zstdData(t, data),
+			maxSize:  2048,
+		},
+		{
+			name:       "Unsupported",
+			encoding:   "br",
+			body:       data,
+			maxSize:    2048,
+			err:        "unsupported content encoding br",
+			statusCode: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:       "GzipInvalid",
+			encoding:   "gzip",
+			body:       data,
+			maxSize:    2048,
+			err:        "invalid gzip body: gzip: invalid header",
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:       "TooLarge",
+			body:       data,
+			maxSize:    1024,
+			readErr:    "http: request body too large",
+			statusCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			// Compressed data is well under the limit, but decompressed data is not.
+			name:       "GzipTooLarge",
+			encoding:   "gzip",
+			body:       gzipData(t, data),
+			maxSize:    1024,
+			readErr:    "http: request body too large",
+			statusCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:       "ZstdTooLarge",
+			encoding:   "zstd",
+			body:       zstdData(t, data),
+			maxSize:    1024,
+			readErr:    "http: request body too large",
+			statusCode: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				log: zerolog.Nop(),
+			}
+
+			r := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(test.body))
+			if test.encoding != "" {
+				r.Header.Set("Content-Encoding", test.encoding)
+			}
+
+			err := s.decodeRequestBody(httptest.NewRecorder(), r, test.maxSize)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+				require.Equal(t, test.statusCode, requestBodyErrorStatus(err))
+
+				return
+			}
+			require.NoError(t, err)
+			require.Empty(t, r.Header.Get("Content-Encoding"))
+
+			body, err := io.ReadAll(r.Body)
+			if test.readErr != "" {
+				require.EqualError(t, err, test.readErr)
+				require.Equal(t, test.statusCode, requestBodyErrorStatus(err))
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, data, body)
+		})
+	}
+}
+
+func TestSendDataEncoding(t *testing.T) {
+	large := bytes.Repeat([]byte("a"), minCompressedResponseSize)
+	small := []byte("a")
+
+	tests := []struct {
+		name     string
+		encoding string
+		data     []byte
+	}{
+		{
+			name: "None",
+			data: large,
+		},
+		{
+			name:     "Gzip",
+			encoding: "gzip",
+			data:     large,
+		},
+		{
+			name:     "Zstd",
+			encoding: "zstd",
+			data:     large,
+		},
+		{
+			name:     "Small",
+			encoding: "gzip",
+			data:     small,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				log: zerolog.Nop(),
+			}
+
+			ctx := context.Background()
+			if test.encoding != "" {
+				ctx = context.WithValue(ctx, responseEncodingContextKey{}, test.encoding)
+			}
+
+			writer := httptest.NewRecorder()
+			s.sendData(ctx, writer, http.StatusOK, contentTypeSSZ, map[string]string{}, test.data)
+			require.Equal(t, http.StatusOK, writer.Code)
+
+			var body []byte
+			switch writer.Header().Get("Content-Encoding") {
+			case "":
+				body = writer.Body.Bytes()
+			case "gzip":
+				reader, err := gzip.NewReader(writer.Body)
+				require.NoError(t, err)
+				body, err = io.ReadAll(reader)
+				require.NoError(t, err)
+			case "zstd":
+				decoder, err := zstd.NewReader(writer.Body)
+				require.NoError(t, err)
+				defer decoder.Close()
+				body, err = io.ReadAll(decoder)
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.data, body)
+
+			if len(test.data) < minCompressedResponseSize {
+				require.Empty(t, writer.Header().Get("Content-Encoding"))
+			} else {
+				require.Equal(t, test.encoding, writer.Header().Get("Content-Encoding"))
+			}
+		})
+	}
+}
+
+func TestPostValidatorRegistrationsEncoded(t *testing.T) {
+	data, err := json.Marshal(testRegistrations(3))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		encoding   string
+		body       []byte
+		maxSize    int64
+		statusCode int
+	}{
+		{
+			name:       "Gzip",
+			encoding:   "gzip",
+			body:       gzipData(t, data),
+			maxSize:    int64(len(data)),
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "Zstd",
+			encoding:   "zstd",
+			body:       zstdData(t, data),
+			maxSize:    int64(len(data)),
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "Unsupported",
+			encoding:   "br",
+			body:       data,
+			maxSize:    int64(len(data)),
+			statusCode: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:       "TooLarge",
+			encoding:   "gzip",
+			body:       gzipData(t, data),
+			maxSize:    int64(len(data)) - 1,
+			statusCode: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				log:                      zerolog.Nop(),
+				maxRegistrationsBodySize: test.maxSize,
+				maxRegistrations:         10,
+				validatorRegistrar:       mockvalidatorregistrar.NewHandler(),
+			}
+
+			r := httptest.NewRequest(http.MethodPost, "/eth/v1/builder/validators", bytes.NewReader(test.body))
+			r.Header.Set("Content-Type", contentTypeJSON)
+			r.Header.Set("Content-Encoding", test.encoding)
+
+			writer := httptest.NewRecorder()
+			s.postValidatorRegistrations(writer, r)
+			require.Equal(t, test.statusCode, writer.Code)
+		})
+	}
+}
@@ -1,4 +1,4 @@
-// Copyright © 2025 Attestant Limited.
+// Copyright © 2025, 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -16,4 +16,10 @@ package rest
 const (
 	// EthConsensusVersion is a header for REST responses that specifies the consensus version of the returned data.
 	EthConsensusVersion = "Eth-Consensus-Version"
+	// RequestID is a header for requests and responses that specifies the correlation ID of the request.
+	RequestID = "X-Request-Id"
+	// TimeoutMs is a header for requests that specifies the time in milliseconds the client will wait for a response.
+	TimeoutMs = "X-Timeout-Ms"
+	// DateMilliseconds is a header for requests that specifies the time the request was sent, in milliseconds since the Unix epoch.
+	DateMilliseconds = "Date-Milliseconds"
 )
@@ -1,4 +1,4 @@
-// Copyright © 2022 - 2025 Attestant Limited.
+// Copyright © 2022 - 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -14,8 +14,20 @@
 package rest
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-block-relay/loggers"
+)
+
+const (
+	contentTypeJSON = "application/json"
+	contentTypeSSZ  = "application/octet-stream"
 )
 
 // APIResponse is a response generated by the REST API.
@@ -25,7 +37,8 @@ type APIResponse struct {
 }
 
 // sendResponse is a helper to send a JSON response.
-func (s *Service) sendResponse(w http.ResponseWriter,
+func (s *Service) sendResponse(ctx context.Context,
+	w http.ResponseWriter,
 	statusCode int,
 	headers map[string]string,
 	resp any,
@@ -39,13 +52,47 @@ func (s *Service) sendResponse(w http.ResponseWriter,
 
 	data, err := json.Marshal(resp)
 	if err != nil {
-		s.log.Error().Err(err).Msg("Failed to marshal response")
+		log := loggers.WithRequestID(ctx, s.log)
+		log.Error().Err(err).Msg("Failed to marshal response")
 		w.WriteHeader(http.StatusInternalServerError)
 
 		return
 	}
 
-	w.Header().Set("Content-Type", "application/json")
+	s.sendData(ctx, w, statusCode, contentTypeJSON, headers, data)
+}
+
+// sendSSZResponse is a helper to send an SSZ response.
+func (s *Service) sendSSZResponse(ctx context.Context,
+	w http.ResponseWriter,
+	statusCode int,
+	headers map[string]string,
+	data []byte,
+) {
+	s.sendData(ctx, w, statusCode, contentTypeSSZ, headers, data)
+}
+
+// sendData is a helper to send pre-marshalled data.
+func (s *Service) sendData(ctx context.Context,
+	w http.ResponseWriter,
+	statusCode int,
+	contentType string,
+	headers map[string]string,
+	data []byte,
+) {
+	if encoding := responseEncoding(ctx); encoding != "" && len(data) >= minCompressedResponseSize {
+		encoded, err := encodeResponse(encoding, data)
+		if err != nil {
+			// Fall back to sending the data unencoded.
+			log := loggers.WithRequestID(ctx, s.log)
+			log.Warn().Err(err).Str("encoding", encoding).Msg("Failed to encode response")
+		} else {
+			data = encoded
+			w.Header().Set("Content-Encoding", encoding)
+		}
+	}
+
+	w.Header().Set("Content-Type", contentType)
 
 	for k, v := range headers {
 		w.Header().Set(k, v)
@@ -53,10 +100,153 @@ func (s *Service) sendResponse(w http.ResponseWriter,
 
 	w.WriteHeader(statusCode)
 
-	_, err = w.Write(data)
+	_, err := w.Write(data)
 	if err != nil {
-		s.log.Error().Err(err).Msg("Failed to write response")
+		log := loggers.WithRequestID(ctx, s.log)
+		log.Error().Err(err).Msg("Failed to write response")
 
 		return
 	}
 }
+
+// acceptedMediaRange is a single media range from an Accept header.
+type acceptedMediaRange struct {
+	mediaType string
+	quality   float64
+}
+
+// obtainAcceptedContentType selects the content type for a response from
+// those supported, based on the request's Accept header.
+// The first supported content type is the default, and is returned if the
+// request has no preference.
+func (s *Service) obtainAcceptedContentType(_ context.Context,
+	r *http.Request,
+	supported ...string,
+) (
+	string,
+	error,
+) {
+	acceptHeaderVals, exists := r.Header["Accept"]
+	if !exists || strings.TrimSpace(strings.Join(acceptHeaderVals, "")) == "" {
+		return supported[0], nil
+	}
+
+	mediaRanges := parseAccept(strings.Join(acceptHeaderVals, ","))
+
+	// Each content type takes its quality from the most specific media range that covers
+	// it, so that an explicit refusal with q=0 is not overridden by a wildcard.
+	selected := ""
+	selectedQuality := 0.0
+	selectedIndex := len(mediaRanges)
+	for _, contentType := range supported {
+		quality, index := contentTypeQuality(mediaRanges, contentType)
+		if quality <= 0 {
+			continue
+		}
+
+		// Ties go to the media range the client listed first, then to the order of preference of the server.
+		if quality > selectedQuality || (quality == selectedQuality && index < selectedIndex) {
+			selected = contentType
+			selectedQuality = quality
+			selectedIndex = index
+		}
+	}
+
+	if selected != "" {
+		return selected, nil
+	}
+
+	return "", fmt.Errorf("none of %s supported", strings.Join(acceptHeaderVals, ","))
+}
+
+// parseAccept parses the value of an Accept header, returning the media
+// ranges in order of preference.
+func parseAccept(header string) []*acceptedMediaRange {
+	mediaRanges := make([]*acceptedMediaRange, 0)
+
+	for entry := range strings.SplitSeq(header, ",") {
+		parts := strings.Split(entry, ";")
+
+		mediaType := strings.ToLower(strings.TrimSpace(parts[0]))
+		if mediaType == "" {
+			continue
+		}
+
+		quality := 1.0
+
+		for _, param := range parts[1:] {
+			key, value, found := strings.Cut(strings.TrimSpace(param), "=")
+			if !found || strings.ToLower(strings.TrimSpace(key)) != "q" {
+				continue
+			}
+
+			tmp, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || tmp < 0 || tmp > 1 {
+				// Invalid quality; treat the media range as unacceptable.
+				tmp = 0
+			}
+
+			quality = tmp
+		}
+
+		mediaRanges = append(mediaRanges, &acceptedMediaRange{
+			mediaType: mediaType,
+			quality:   quality,
+		})
+	}
+
+	// Stable sort retains the client's ordering for media ranges of equal quality.
+	sort.SliceStable(mediaRanges, func(i int, j int) bool {
+		return mediaRanges[i].quality > mediaRanges[j].quality
+	})
+
+	return mediaRanges
+}
+
+// contentTypeQuality returns the quality of a content type, taken from the most specific
+// media range that covers it, along with the index of that media range.
+// If no media range covers the content type its quality is 0.
+func contentTypeQuality(mediaRanges []*acceptedMediaRange, contentType string) (float64, int) {
+	quality := 0.0
+	index := len(mediaRanges)
+	specificity := -1
+	for i, mediaRange := range mediaRanges {
+		if !mediaTypeMatches(mediaRange.mediaType, contentType) {
+			continue
+		}
+
+		rangeSpecificity := mediaRangeSpecificity(mediaRange.mediaType)
+		if rangeSpecificity > specificity {
+			quality = mediaRange.quality
+			index = i
+			specificity = rangeSpecificity
+		}
+	}
+
+	return quality, index
+}
+
+// mediaRangeSpecificity returns the specificity of a media range, with higher values being more specific.
+func mediaRangeSpecificity(mediaRange string) int {
+	switch {
+	case mediaRange == "*/*":
+		return 0
+	case strings.HasSuffix(mediaRange, "/*"):
+		return 1
+	default:
+		return 2
+	}
+}
+
+// mediaTypeMatches returns true if the media range covers the content type.
+func mediaTypeMatches(mediaRange string, contentType string) bool {
+	if mediaRange == "*/*" || mediaRange == contentType {
+		return true
+	}
+
+	if prefix, found := strings.CutSuffix(mediaRange, "/*"); found {
+		return strings.HasPrefix(contentType, prefix+"/")
+	}
+
+	return false
+}
@@ -0,0 +1,143 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestObtainAcceptedContentType(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{}
+
+	tests := []struct {
+		name        string
+		accept      []string
+		contentType string
+		err         string
+	}{
+		{
+			name:        "Missing",
+			contentType: contentTypeJSON,
+		},
+		{
+			name:        "Empty",
+			accept:      []string{""},
+			contentType: contentTypeJSON,
+		},
+		{
+			name:        "Wildcard",
+			accept:      []string{"*/*"},
+			contentType: contentTypeJSON,
+		},
+		{
+			name:        "ApplicationWildcard",
+			accept:      []string{"application/*"},
+			contentType: contentTypeJSON,
+		},
+		{
+			name:        "JSON",
+			accept:      []string{"application/json"},
+			contentType: contentTypeJSON,
+		},
+		{
+			name:        "SSZ",
+			accept:      []string{"application/octet-stream"},
+			contentType: contentTypeSSZ,
+		},
+		{
+			name:        "SSZUpperCase",
+			accept:      []string{"Application/Octet-Stream"},
+			contentType: contentTypeSSZ,
+		},
+		{
+			name:        "Ordered",
+			accept:      []string{"application/octet-stream,application/json"},
+			contentType: contentTypeSSZ,
+		},
+		{
+			name:        "QValues",
+			accept:      []string{"application/json;q=0.5,application/octet-stream;q=0.9"},
+			contentType: contentTypeSSZ,
+		},
+		{
+			name:        "QValuesSpaced",
+			accept:      []string{"application/octet-stream; q=0.2, application/json ; q=0.8"},
+			contentType: contentTypeJSON,
+		},
+		{
+			name:        "MultipleHeaders",
+			accept:      []string{"text/html", "application/octet-stream"},
+			contentType: contentTypeSSZ,
+		},
+		{
+			name:        "Fallback",
+			accept:      []string{"text/html,*/*;q=0.1"},
+			contentType: contentTypeJSON,
+		},
+		{
+			name:        "RefusedWithWildcard",
+			accept:      []string{"application/json;q=0, */*"},
+			contentType: contentTypeSSZ,
+		},
+		{
+			name:        "SpecificOverridesWildcard",
+			accept:      []string{"*/*,application/json;q=0.5"},
+			contentType: contentTypeSSZ,
+		},
+		{
+			name:   "RefusedWithTypeWildcard",
+			accept: []string{"application/*;q=0,*/*"},
+			err:    "none of application/*;q=0,*/* supported",
+		},
+		{
+			name:   "ZeroQuality",
+			accept: []string{"application/json;q=0,application/octet-stream;q=0"},
+			err:    "none of application/json;q=0,application/octet-stream;q=0 supported",
+		},
+		{
+			name:   "InvalidQuality",
+			accept: []string{"application/json;q=high"},
+			err:    "none of application/json;q=high supported",
+		},
+		{
+			name:   "Unsupported",
+			accept: []string{"text/html"},
+			err:    "none of text/html supported",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := &http.Request{
+				Header: http.Header{},
+			}
+			if test.accept != nil {
+				r.Header["Accept"] = test.accept
+			}
+
+			contentType, err := s.obtainAcceptedContentType(ctx, r, contentTypeJSON, contentTypeSSZ)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.contentType, contentType)
+			}
+		})
+	}
+}
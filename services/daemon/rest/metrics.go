@@ -1,4 +1,4 @@
-// Copyright © 2022 Attestant Limited.
+// Copyright © 2022, 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -15,6 +15,8 @@ package rest
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/attestantio/go-block-relay/services/metrics"
 	"github.com/pkg/errors"
@@ -23,43 +25,124 @@ import (
 
 var metricsNamespace = "blockrelay"
 
-var requests *prometheus.CounterVec
+// sizeBuckets are the buckets for request and response body sizes, from 256B to 16MiB.
+var sizeBuckets = prometheus.ExponentialBuckets(256, 4, 9)
 
-func registerMetrics(ctx context.Context, monitor metrics.Service) error {
-	if requests != nil {
-		// Already registered.
-		return nil
-	}
+// serviceMetrics are the metrics for a single instance of the service.
+type serviceMetrics struct {
+	requests         *prometheus.CounterVec
+	requestDurations *prometheus.HistogramVec
+	requestsInFlight *prometheus.GaugeVec
+	requestSizes     *prometheus.HistogramVec
+	responseSizes    *prometheus.HistogramVec
+}
 
+// registerMetrics registers the service's metrics with the monitor.
+// It returns nil if the monitor does not collect metrics.
+func registerMetrics(ctx context.Context, monitor metrics.Service) (*serviceMetrics, error) {
 	if monitor == nil {
 		// No monitor.
-		return nil
+		return nil, nil
 	}
 
-	if monitor.Presenter() == "prometheus" {
-		return registerPrometheusMetrics(ctx)
+	registerer := monitor.Registerer()
+	if registerer == nil {
+		// Monitor does not collect metrics.
+		return nil, nil
 	}
 
-	return nil
+	return registerPrometheusMetrics(ctx, registerer)
 }
 
-func registerPrometheusMetrics(_ context.Context) error {
-	requests = prometheus.NewCounterVec(prometheus.CounterOpts{
+func registerPrometheusMetrics(_ context.Context, registerer prometheus.Registerer) (*serviceMetrics, error) {
+	m := &serviceMetrics{}
+
+	m.requests = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Namespace: metricsNamespace,
 		Name:      "requests_total",
 		Help:      "Requests",
 	}, []string{"request", "result"})
 
-	err := prometheus.Register(requests)
+	err := registerer.Register(m.requests)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register requests_total")
+	}
+
+	m.requestDurations = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "request_duration_seconds",
+		Help:      "Time taken to handle requests",
+		Buckets: []float64{
+			0.005, 0.01, 0.025, 0.05, 0.1, 0.15, 0.2, 0.25, 0.3, 0.4, 0.5, 0.75, 1.0, 1.5, 2.0, 3.0,
+		},
+	}, []string{"endpoint", "method", "code"})
+
+	err = registerer.Register(m.requestDurations)
 	if err != nil {
-		return errors.Wrap(err, "failed to register requests_total")
+		return nil, errors.Wrap(err, "failed to register request_duration_seconds")
 	}
 
-	return nil
+	m.requestsInFlight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "requests_in_flight",
+		Help:      "Requests currently being handled",
+	}, []string{"endpoint"})
+
+	err = registerer.Register(m.requestsInFlight)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register requests_in_flight")
+	}
+
+	m.requestSizes = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "request_size_bytes",
+		Help:      "Size of request bodies",
+		Buckets:   sizeBuckets,
+	}, []string{"endpoint"})
+
+	err = registerer.Register(m.requestSizes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register request_size_bytes")
+	}
+
+	m.responseSizes = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "response_size_bytes",
+		Help:      "Size of response bodies",
+		Buckets:   sizeBuckets,
+	}, []string{"endpoint"})
+
+	err = registerer.Register(m.responseSizes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register response_size_bytes")
+	}
+
+	return m, nil
+}
+
+func (s *Service) monitorRequestHandled(request string, result string) {
+	if s.metrics != nil {
+		s.metrics.requests.WithLabelValues(request, result).Inc()
+	}
+}
+
+func (s *Service) monitorRequestStarted(endpoint string) {
+	if s.metrics != nil {
+		s.metrics.requestsInFlight.WithLabelValues(endpoint).Inc()
+	}
 }
 
-func monitorRequestHandled(request string, result string) {
-	if requests != nil {
-		requests.WithLabelValues(request, result).Inc()
+func (s *Service) monitorRequestCompleted(endpoint string,
+	method string,
+	statusCode int,
+	duration time.Duration,
+	requestSize int64,
+	responseSize int64,
+) {
+	if s.metrics != nil {
+		s.metrics.requestsInFlight.WithLabelValues(endpoint).Dec()
+		s.metrics.requestDurations.WithLabelValues(endpoint, method, strconv.Itoa(statusCode)).Observe(duration.Seconds())
+		s.metrics.requestSizes.WithLabelValues(endpoint).Observe(float64(requestSize))
+		s.metrics.responseSizes.WithLabelValues(endpoint).Observe(float64(responseSize))
 	}
 }
@@ -1,4 +1,4 @@
-// Copyright © 2022 Attestant Limited.
+// Copyright © 2022, 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -15,10 +15,18 @@ package rest
 
 import (
 	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	nullmetrics "github.com/attestantio/go-block-relay/services/metrics/null"
 	prometheusmetrics "github.com/attestantio/go-block-relay/services/metrics/prometheus"
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/require"
 )
 
@@ -26,28 +34,121 @@ func TestRegisterMetrics(t *testing.T) {
 	ctx := context.Background()
 
 	// Ensure metrics handler can be called without failing.
-	monitorRequestHandled("test", "success")
+	(&Service{}).monitorRequestHandled("test", "success")
 
 	// Ensure metrics can be registered without monitor.
-	require.NoError(t, registerMetrics(ctx, nil))
+	m, err := registerMetrics(ctx, nil)
+	require.NoError(t, err)
+	require.Nil(t, m)
 
 	// Ensure metrics can be registered with a null monitor.
 	nullMonitor := nullmetrics.New()
-	require.NoError(t, registerMetrics(ctx, nullMonitor))
+	m, err = registerMetrics(ctx, nullMonitor)
+	require.NoError(t, err)
+	require.Nil(t, m)
 
 	// Ensure metrics can be registered with a prometheus monitor.
 	monitor, err := prometheusmetrics.New(ctx,
 		prometheusmetrics.WithAddress(":14632"),
 	)
 	require.NoError(t, err)
-	require.NoError(t, registerMetrics(ctx, monitor))
+	m, err = registerMetrics(ctx, monitor)
+	require.NoError(t, err)
+	require.NotNil(t, m)
 
-	// Ensure metrics can be re-registered without error.
-	require.NoError(t, registerMetrics(ctx, monitor))
+	// Ensure metrics for a second service can be registered with a separate monitor.
+	monitor2, err := prometheusmetrics.New(ctx,
+		prometheusmetrics.WithAddress(":14633"),
+	)
+	require.NoError(t, err)
+	m2, err := registerMetrics(ctx, monitor2)
+	require.NoError(t, err)
+	require.NotNil(t, m2)
 
-	// Ensure intneral function recognises double registration and errors.
-	require.EqualError(t, registerPrometheusMetrics(ctx), "failed to register requests_total: duplicate metrics collector registration attempted")
+	// Ensure metrics are isolated between services.
+	s := &Service{metrics: m}
+	s.monitorRequestHandled("test", "success")
+	require.Equal(t, float64(1), testutil.ToFloat64(m.requests.WithLabelValues("test", "success")))
+	require.Equal(t, float64(0), testutil.ToFloat64(m2.requests.WithLabelValues("test", "success")))
 
-	// Ensure metrics handler can be called without failing.
-	monitorRequestHandled("test", "success")
+	// Ensure double registration with the same monitor errors.
+	_, err = registerMetrics(ctx, monitor)
+	require.EqualError(t, err, "failed to register requests_total: duplicate metrics collector registration attempted")
+}
+
+func TestMonitorRequests(t *testing.T) {
+	ctx := context.Background()
+
+	m, err := registerPrometheusMetrics(ctx, prometheus.NewRegistry())
+	require.NoError(t, err)
+
+	s := &Service{
+		metrics: m,
+	}
+
+	router := mux.NewRouter()
+	router.HandleFunc("/test/{id}", func(w http.ResponseWriter, r *http.Request) {
+		_, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		w.WriteHeader(http.StatusAccepted)
+		_, err = w.Write([]byte("response"))
+		require.NoError(t, err)
+	}).Methods(http.MethodPost)
+	router.HandleFunc("/implicit", func(w http.ResponseWriter, _ *http.Request) {
+		_, err := w.Write([]byte("ok"))
+		require.NoError(t, err)
+	}).Methods(http.MethodGet)
+	router.Use(s.monitorRequests)
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		body         string
+		endpoint     string
+		code         string
+		requestSize  float64
+		responseSize float64
+	}{
+		{
+			name:         "Explicit",
+			method:       http.MethodPost,
+			path:         "/test/1",
+			body:         "request body",
+			endpoint:     "/test/{id}",
+			code:         "202",
+			requestSize:  12,
+			responseSize: 8,
+		},
+		{
+			name:         "Implicit",
+			method:       http.MethodGet,
+			path:         "/implicit",
+			endpoint:     "/implicit",
+			code:         "200",
+			responseSize: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := httptest.NewRequest(test.method, test.path, strings.NewReader(test.body))
+			writer := httptest.NewRecorder()
+			router.ServeHTTP(writer, request)
+
+			require.Equal(t, float64(0), testutil.ToFloat64(m.requestsInFlight.WithLabelValues(test.endpoint)))
+			require.Equal(t, uint64(1), histogram(t, m.requestDurations.WithLabelValues(test.endpoint, test.method, test.code)).GetSampleCount())
+			require.Equal(t, test.requestSize, histogram(t, m.requestSizes.WithLabelValues(test.endpoint)).GetSampleSum())
+			require.Equal(t, test.responseSize, histogram(t, m.responseSizes.WithLabelValues(test.endpoint)).GetSampleSum())
+		})
+	}
+}
+
+func histogram(t *testing.T, observer prometheus.Observer) *dto.Histogram {
+	t.Helper()
+
+	metric := &dto.Metric{}
+	require.NoError(t, observer.(prometheus.Metric).Write(metric))
+
+	return metric.GetHistogram()
 }
@@ -0,0 +1,230 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/attestantio/go-block-relay/loggers"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxRequestIDLength is the maximum length of a request ID supplied by a caller.
+const maxRequestIDLength = 128
+
+// buildHandler builds the handler for the daemon, wrapping the router in a
+// single middleware chain that applies to every route.
+func (s *Service) buildHandler(router http.Handler,
+	trustedProxies []string,
+) (
+	http.Handler,
+	error,
+) {
+	// Set to release mode to remove debug logging.
+	gin.SetMode(gin.ReleaseMode)
+
+	engine := gin.New()
+
+	err := engine.SetTrustedProxies(trustedProxies)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to set trusted proxies")
+	}
+
+	// Request ID is assigned first so that it is available to all subsequent logs.
+	engine.Use(s.assignRequestID)
+	// Logger wraps recovery so that it records the status of recovered requests.
+	engine.Use(loggers.NewGinLogger(s.log))
+	engine.Use(gin.CustomRecoveryWithWriter(io.Discard, s.recoverPanic))
+	engine.Use(s.selectResponseEncoding)
+
+	// All routing is carried out by the router, so send every request to it.
+	engine.Any("/*path", gin.WrapH(router))
+
+	return engine, nil
+}
+
+// assignRequestID attaches a request ID to the request's context and echoes
+// it in the response.  The caller's ID is used if it is acceptable, otherwise
+// a new ID is generated.
+func (*Service) assignRequestID(c *gin.Context) {
+	requestID := c.GetHeader(RequestID)
+	if !validRequestID(requestID) {
+		requestID = generateRequestID()
+	}
+
+	c.Request = c.Request.WithContext(loggers.ContextWithRequestID(c.Request.Context(), requestID))
+	c.Header(RequestID, requestID)
+
+	c.Next()
+}
+
+// validRequestID returns true if the supplied request ID is safe to use.
+func validRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+
+	for _, ch := range requestID {
+		// Printable ASCII only, to keep request IDs safe for logs and headers.
+		if ch <= ' ' || ch > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// generateRequestID generates a random request ID.
+func generateRequestID() string {
+	data := make([]byte, 16)
+	// Read never returns an error.
+	_, _ = rand.Read(data)
+
+	return hex.EncodeToString(data)
+}
+
+// recoverPanic handles a panic in a request handler.
+func (s *Service) recoverPanic(c *gin.Context, recovered any) {
+	log := loggers.WithRequestID(c.Request.Context(), s.log)
+	log.Error().
+		Str("method", c.Request.Method).
+		Str("path", c.Request.URL.Path).
+		Str("panic", fmt.Sprintf("%v", recovered)).
+		Str("stack", string(debug.Stack())).
+		Msg("Request handler panicked")
+
+	if c.Writer.Written() {
+		// Too late to send a response; abandon the request.
+		c.Abort()
+
+		return
+	}
+
+	s.sendResponse(c.Request.Context(), c.Writer,
+		http.StatusInternalServerError,
+		map[string]string{},
+		&APIResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "Internal server error",
+		})
+	c.Abort()
+}
+
+// monitorRequests is router middleware that records metrics and a trace span for each request.
+func (s *Service) monitorRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Path templates are used as the endpoint to keep label cardinality bounded.
+		endpoint := "unknown"
+		if route := mux.CurrentRoute(r); route != nil {
+			template, err := route.GetPathTemplate()
+			if err == nil {
+				endpoint = template
+			}
+		}
+
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := otel.Tracer("attestantio.go-block-relay.services.daemon.rest").Start(ctx,
+			fmt.Sprintf("%s %s", r.Method, endpoint),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", r.Method),
+				attribute.String("http.route", endpoint),
+				attribute.String("request_id", loggers.RequestID(ctx)),
+			),
+		)
+		defer span.End()
+		r = r.WithContext(ctx)
+
+		started := time.Now()
+		s.inFlight.Add(1)
+		defer s.inFlight.Add(-1)
+		s.monitorRequestStarted(endpoint)
+
+		body := &countingReadCloser{ReadCloser: r.Body}
+		if r.Body != nil {
+			r.Body = body
+		}
+		writer := &countingResponseWriter{
+			ResponseWriter: w,
+			statusCode:     http.StatusOK,
+		}
+
+		completed := false
+		defer func() {
+			if !completed {
+				// Handler panicked; recovery will respond with an internal server error.
+				writer.statusCode = http.StatusInternalServerError
+			}
+			s.monitorRequestCompleted(endpoint, r.Method, writer.statusCode, time.Since(started), body.size, writer.size)
+
+			span.SetAttributes(attribute.Int("http.response.status_code", writer.statusCode))
+			if writer.statusCode >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(writer.statusCode))
+			}
+		}()
+
+		next.ServeHTTP(writer, r)
+		completed = true
+	})
+}
+
+// countingReadCloser counts the bytes read from a request body.
+type countingReadCloser struct {
+	io.ReadCloser
+	size int64
+}
+
+func (c *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.size += int64(n)
+
+	return n, err
+}
+
+// countingResponseWriter captures the status code and counts the bytes written in a response.
+type countingResponseWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+	size        int64
+}
+
+func (c *countingResponseWriter) WriteHeader(statusCode int) {
+	if !c.wroteHeader {
+		c.statusCode = statusCode
+		c.wroteHeader = true
+	}
+	c.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (c *countingResponseWriter) Write(data []byte) (int, error) {
+	c.wroteHeader = true
+	n, err := c.ResponseWriter.Write(data)
+	c.size += int64(n)
+
+	return n, err
+}
@@ -0,0 +1,252 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-block-relay/loggers"
+	mockauctioneer "github.com/attestantio/go-block-relay/services/blockauctioneer/mock"
+	mockbuilderbidprovider "github.com/attestantio/go-block-relay/services/builderbidprovider/mock"
+	"github.com/attestantio/go-block-relay/testing/logger"
+	"github.com/gorilla/mux"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestBuildHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		path           string
+		remoteAddr     string
+		forwardedFor   string
+		statusCode     int
+		response       *APIResponse
+		client         string
+		err            string
+	}{
+		{
+			name:           "TrustedProxiesInvalid",
+			trustedProxies: []string{"invalid"},
+			err:            "failed to set trusted proxies: invalid IP address: invalid",
+		},
+		{
+			name:       "Good",
+			path:       "/good",
+			remoteAddr: "10.0.0.1:1234",
+			statusCode: http.StatusOK,
+			client:     "10.0.0.1",
+		},
+		{
+			name:       "Unhandled",
+			path:       "/unhandled",
+			remoteAddr: "10.0.0.1:1234",
+			statusCode: http.StatusNotFound,
+			client:     "10.0.0.1",
+		},
+		{
+			name:       "Panic",
+			path:       "/panic",
+			remoteAddr: "10.0.0.1:1234",
+			statusCode: http.StatusInternalServerError,
+			response: &APIResponse{
+				Code:    http.StatusInternalServerError,
+				Message: "Internal server error",
+			},
+			client: "10.0.0.1",
+		},
+		{
+			name:         "ForwardedUntrusted",
+			path:         "/good",
+			remoteAddr:   "10.0.0.1:1234",
+			forwardedFor: "192.168.1.1",
+			statusCode:   http.StatusOK,
+			client:       "10.0.0.1",
+		},
+		{
+			name:           "ForwardedTrusted",
+			trustedProxies: []string{"10.0.0.0/8"},
+			path:           "/good",
+			remoteAddr:     "10.0.0.1:1234",
+			forwardedFor:   "192.168.1.1",
+			statusCode:     http.StatusOK,
+			client:         "192.168.1.1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			capture := logger.NewLogCapture()
+			s := &Service{
+				log: zerolog.New(capture).Level(zerolog.TraceLevel),
+			}
+
+			router := mux.NewRouter()
+			router.HandleFunc("/good", func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			router.HandleFunc("/panic", func(_ http.ResponseWriter, _ *http.Request) {
+				panic("bad")
+			})
+			router.PathPrefix("/").Handler(s)
+
+			handler, err := s.buildHandler(router, test.trustedProxies)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodGet, test.path, nil)
+			request.RemoteAddr = test.remoteAddr
+			if test.forwardedFor != "" {
+				request.Header.Set("X-Forwarded-For", test.forwardedFor)
+			}
+			writer := httptest.NewRecorder()
+			handler.ServeHTTP(writer, request)
+
+			require.Equal(t, test.statusCode, writer.Code)
+			if test.response != nil {
+				require.Equal(t, contentTypeJSON, writer.Header().Get("Content-Type"))
+				response := &APIResponse{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), response))
+				require.Equal(t, test.response, response)
+			}
+			require.True(t, capture.HasLog(map[string]any{
+				"status_code": test.statusCode,
+				"path":        test.path,
+				"client":      test.client,
+			}))
+		})
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name      string
+		requestID string
+		generated bool
+	}{
+		{
+			name:      "Missing",
+			generated: true,
+		},
+		{
+			name:      "Supplied",
+			requestID: "abc-123",
+		},
+		{
+			name:      "Invalid",
+			requestID: "abc 123",
+			generated: true,
+		},
+		{
+			name:      "TooLong",
+			requestID: strings.Repeat("a", maxRequestIDLength+1),
+			generated: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			capture := logger.NewLogCapture()
+			s := &Service{
+				log: zerolog.New(capture).Level(zerolog.TraceLevel),
+			}
+
+			var contextRequestID string
+			router := mux.NewRouter()
+			router.HandleFunc("/good", func(w http.ResponseWriter, r *http.Request) {
+				contextRequestID = loggers.RequestID(r.Context())
+				s.getStatus(w, r)
+			})
+
+			handler, err := s.buildHandler(router, nil)
+			require.NoError(t, err)
+
+			request := httptest.NewRequest(http.MethodGet, "/good", nil)
+			if test.requestID != "" {
+				request.Header.Set(RequestID, test.requestID)
+			}
+			writer := httptest.NewRecorder()
+			handler.ServeHTTP(writer, request)
+
+			requestID := writer.Header().Get(RequestID)
+			if test.generated {
+				require.Len(t, requestID, 32)
+				require.NotEqual(t, test.requestID, requestID)
+			} else {
+				require.Equal(t, test.requestID, requestID)
+			}
+			require.Equal(t, requestID, contextRequestID)
+			require.True(t, capture.HasLog(map[string]any{
+				"message":    "Status requested",
+				"request_id": requestID,
+			}))
+			require.True(t, capture.HasLog(map[string]any{
+				"status_code": http.StatusOK,
+				"request_id":  requestID,
+			}))
+		})
+	}
+}
+
+func TestMonitorRequestsTrace(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+
+	s := &Service{
+		log:                zerolog.Nop(),
+		maxBidTimeout:      time.Second,
+		blockAuctioneer:    mockauctioneer.New(),
+		builderBidProvider: mockbuilderbidprovider.New(),
+	}
+
+	router := mux.NewRouter()
+	router.HandleFunc("/eth/v1/builder/header/{slot}/{parenthash}/{pubkey}", s.getBuilderBid).Methods(http.MethodGet)
+	router.Use(s.monitorRequests)
+
+	request := httptest.NewRequest(http.MethodGet,
+		"/eth/v1/builder/header/1/0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f/0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f",
+		nil,
+	)
+	writer := httptest.NewRecorder()
+	router.ServeHTTP(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	require.Equal(t, "GET /eth/v1/builder/header/{slot}/{parenthash}/{pubkey}", spans[0].Name())
+
+	attributes := make(map[attribute.Key]attribute.Value)
+	for _, attr := range spans[0].Attributes() {
+		attributes[attr.Key] = attr.Value
+	}
+	require.Equal(t, int64(1), attributes["slot"].AsInt64())
+	require.Equal(t, "0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f", attributes["pubkey"].AsString())
+	require.Equal(t, int64(http.StatusOK), attributes["http.response.status_code"].AsInt64())
+}
@@ -1,4 +1,4 @@
-// Copyright © 2022, 2024 Attestant Limited.
+// Copyright © 2022 - 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -15,25 +15,46 @@ package rest
 
 import (
 	"errors"
+	"time"
 
+	"github.com/attestantio/go-block-relay/services/bidtracerecorder"
 	"github.com/attestantio/go-block-relay/services/blockauctioneer"
 	"github.com/attestantio/go-block-relay/services/blockunblinder"
 	"github.com/attestantio/go-block-relay/services/builderbidprovider"
+	"github.com/attestantio/go-block-relay/services/builderblocksubmitter"
 	"github.com/attestantio/go-block-relay/services/metrics"
 	nullmetrics "github.com/attestantio/go-block-relay/services/metrics/null"
+	"github.com/attestantio/go-block-relay/services/proposerdutiesprovider"
 	"github.com/attestantio/go-block-relay/services/validatorregistrar"
 	"github.com/rs/zerolog"
 )
 
 type parameters struct {
-	logLevel           zerolog.Level
-	monitor            metrics.Service
-	serverName         string
-	listenAddress      string
-	validatorRegistrar validatorregistrar.Service
-	blockAuctioneer    blockauctioneer.Service
-	builderBidProvider builderbidprovider.Service
-	blockUnblinder     blockunblinder.Service
+	logLevel                 zerolog.Level
+	monitor                  metrics.Service
+	serverName               string
+	listenAddress            string
+	serverCertFile           string
+	serverKeyFile            string
+	autoCert                 bool
+	autoCertCacheDir         string
+	maxBidTimeout            time.Duration
+	drainTimeout             time.Duration
+	shutdownDelay            time.Duration
+	maxRequestBodySize       int64
+	maxRegistrationsBodySize int64
+	maxRegistrations         int
+	maxBlindedBlockBodySize  int64
+	registrationsChunkSize   int
+	trustedProxies           []string
+	validatorRegistrar       validatorregistrar.Service
+	blockAuctioneer          blockauctioneer.Service
+	auctionBids              bool
+	builderBidProvider       builderbidprovider.Service
+	blockUnblinder           blockunblinder.Service
+	bidTraceRecorder         bidtracerecorder.Service
+	blockSubmitter           builderblocksubmitter.Service
+	dutiesProvider           proposerdutiesprovider.Service
 }
 
 // Parameter is the interface for service parameters.
@@ -75,6 +96,103 @@ func WithListenAddress(listenAddress string) Parameter {
 	})
 }
 
+// WithServerCertFile sets the file containing the PEM-encoded server certificate.
+func WithServerCertFile(file string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.serverCertFile = file
+	})
+}
+
+// WithServerKeyFile sets the file containing the PEM-encoded server key.
+func WithServerKeyFile(file string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.serverKeyFile = file
+	})
+}
+
+// WithAutoCert sets whether to obtain server certificates automatically using ACME.
+func WithAutoCert(autoCert bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.autoCert = autoCert
+	})
+}
+
+// WithAutoCertCacheDir sets the directory in which to cache automatically obtained certificates.
+func WithAutoCertCacheDir(dir string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.autoCertCacheDir = dir
+	})
+}
+
+// WithMaxBidTimeout sets the maximum time to spend obtaining a builder bid.
+// Requests can ask for a shorter time using the X-Timeout-Ms header.
+func WithMaxBidTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxBidTimeout = timeout
+	})
+}
+
+// WithDrainTimeout sets the maximum time to wait for in-flight requests to complete when shutting down.
+func WithDrainTimeout(timeout time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.drainTimeout = timeout
+	})
+}
+
+// WithShutdownDelay sets the time between reporting as unavailable and ceasing to accept requests when shutting down.
+// This gives load balancers and clients the opportunity to stop sending requests to the service.
+func WithShutdownDelay(delay time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.shutdownDelay = delay
+	})
+}
+
+// WithMaxRequestBodySize sets the maximum size of a request body, after any content encoding is removed.
+// This applies to endpoints that do not have their own limit.
+func WithMaxRequestBodySize(size int64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxRequestBodySize = size
+	})
+}
+
+// WithMaxRegistrationsBodySize sets the maximum size of a validator registrations request body.
+func WithMaxRegistrationsBodySize(size int64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxRegistrationsBodySize = size
+	})
+}
+
+// WithMaxRegistrations sets the maximum number of validator registrations in a single request.
+func WithMaxRegistrations(count int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxRegistrations = count
+	})
+}
+
+// WithRegistrationsChunkSize sets the number of validator registrations passed to the handler at a time.
+// If set, JSON registrations are decoded as they are read from the request rather than all at once.
+// By default registrations are passed to the handler in a single call.
+func WithRegistrationsChunkSize(size int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.registrationsChunkSize = size
+	})
+}
+
+// WithMaxBlindedBlockBodySize sets the maximum size of an unblind block request body.
+func WithMaxBlindedBlockBodySize(size int64) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.maxBlindedBlockBodySize = size
+	})
+}
+
+// WithTrustedProxies sets the addresses or CIDR ranges of proxies trusted to supply the client IP.
+// By default no proxies are trusted, and the client IP is the remote address of the connection.
+func WithTrustedProxies(proxies []string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.trustedProxies = proxies
+	})
+}
+
 // WithValidatorRegistrar sets the validator registrar.
 func WithValidatorRegistrar(validatorRegistrar validatorregistrar.Service) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -96,6 +214,14 @@ func WithBlockAuctioneer(blockAuctioneer blockauctioneer.Service) Parameter {
 	})
 }
 
+// WithAuctionBids sets whether bids served to proposers are obtained by auction from the block auctioneer.
+// If disabled, bids are obtained from the builder bid provider instead.  Defaults to disabled.
+func WithAuctionBids(auctionBids bool) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.auctionBids = auctionBids
+	})
+}
+
 // WithBlockUnblinder sets the block unblinder.
 func WithBlockUnblinder(blockUnblinder blockunblinder.Service) Parameter {
 	return parameterFunc(func(p *parameters) {
@@ -103,11 +229,43 @@ func WithBlockUnblinder(blockUnblinder blockunblinder.Service) Parameter {
 	})
 }
 
+// WithBidTraceRecorder sets the bid trace recorder.
+// If not supplied bid traces are not recorded, and the relay data API is unavailable.
+func WithBidTraceRecorder(recorder bidtracerecorder.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.bidTraceRecorder = recorder
+	})
+}
+
+// WithBuilderBlockSubmitter sets the builder block submitter.
+// If not supplied builders cannot submit blocks to the relay.
+func WithBuilderBlockSubmitter(submitter builderblocksubmitter.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.blockSubmitter = submitter
+	})
+}
+
+// WithProposerDutiesProvider sets the proposer duties provider.
+// If not supplied builders cannot obtain the registrations of upcoming proposers.
+func WithProposerDutiesProvider(provider proposerdutiesprovider.Service) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.dutiesProvider = provider
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
-		logLevel: zerolog.GlobalLevel(),
-		monitor:  nullmetrics.New(),
+		logLevel:                 zerolog.GlobalLevel(),
+		monitor:                  nullmetrics.New(),
+		autoCertCacheDir:         "certs",
+		maxBidTimeout:            time.Second,
+		auctionBids:              false,
+		drainTimeout:             10 * time.Second,
+		maxRequestBodySize:       64 * 1024 * 1024,
+		maxRegistrationsBodySize: 64 * 1024 * 1024,
+		maxRegistrations:         100000,
+		maxBlindedBlockBodySize:  16 * 1024 * 1024,
 	}
 
 	for _, p := range params {
@@ -119,15 +277,65 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	if parameters.monitor == nil {
 		return nil, errors.New("no monitor specified")
 	}
-	// At current the server name is not required, as the daemon does not run on HTTPS.
-	// if parameters.serverName == "" {
-	// 	return nil, errors.New("no server name specified")
-	// }
+	if parameters.serverCertFile != "" && parameters.serverKeyFile == "" {
+		return nil, errors.New("server certificate file specified without server key file")
+	}
+
+	if parameters.serverKeyFile != "" && parameters.serverCertFile == "" {
+		return nil, errors.New("server key file specified without server certificate file")
+	}
+
+	if parameters.autoCert {
+		if parameters.serverCertFile != "" {
+			return nil, errors.New("cannot use both automatic certificates and a server certificate file")
+		}
+
+		// The server name is only required when obtaining certificates automatically.
+		if parameters.serverName == "" {
+			return nil, errors.New("no server name specified")
+		}
+
+		if parameters.autoCertCacheDir == "" {
+			return nil, errors.New("no automatic certificate cache directory specified")
+		}
+	}
 
 	if parameters.listenAddress == "" {
 		return nil, errors.New("no listen address specified")
 	}
 
+	if parameters.maxBidTimeout <= 0 {
+		return nil, errors.New("max bid timeout must be greater than 0")
+	}
+
+	if parameters.drainTimeout <= 0 {
+		return nil, errors.New("drain timeout must be greater than 0")
+	}
+
+	if parameters.shutdownDelay < 0 {
+		return nil, errors.New("shutdown delay cannot be negative")
+	}
+
+	if parameters.maxRequestBodySize <= 0 {
+		return nil, errors.New("max request body size must be greater than 0")
+	}
+
+	if parameters.maxRegistrationsBodySize <= 0 {
+		return nil, errors.New("max registrations body size must be greater than 0")
+	}
+
+	if parameters.maxRegistrations <= 0 {
+		return nil, errors.New("max registrations must be greater than 0")
+	}
+
+	if parameters.maxBlindedBlockBodySize <= 0 {
+		return nil, errors.New("max blinded block body size must be greater than 0")
+	}
+
+	if parameters.registrationsChunkSize < 0 {
+		return nil, errors.New("registrations chunk size cannot be negative")
+	}
+
 	if parameters.validatorRegistrar == nil {
 		return nil, errors.New("no validator registrar specified")
 	}
@@ -136,11 +344,16 @@ func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 		return nil, errors.New("no block auctioneer specified")
 	}
 
+	if _, isAuctioneer := parameters.blockAuctioneer.(blockauctioneer.BlockAuctioneer); parameters.auctionBids && !isAuctioneer {
+		return nil, errors.New("block auctioneer cannot run auctions; auction bids require a block auctioneer that can")
+	}
+
 	if parameters.blockUnblinder == nil {
 		return nil, errors.New("no block unblinder specified")
 	}
 
-	if parameters.builderBidProvider == nil {
+	// The builder bid provider is only used when bids are not obtained by auction.
+	if !parameters.auctionBids && parameters.builderBidProvider == nil {
 		return nil, errors.New("no builder bid provider specified")
 	}
 
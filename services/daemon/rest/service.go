@@ -1,4 +1,4 @@
-// Copyright © 2022, 2024 Attestant Limited.
+// Copyright © 2022 - 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -15,31 +15,61 @@ package rest
 
 import (
 	"context"
+	"crypto/tls"
 	"net/http"
 	"os"
 	"os/signal"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/attestantio/go-block-relay/loggers"
+	"github.com/attestantio/go-block-relay/services/bidtracerecorder"
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
 	"github.com/attestantio/go-block-relay/services/blockunblinder"
 	"github.com/attestantio/go-block-relay/services/builderbidprovider"
+	"github.com/attestantio/go-block-relay/services/builderblocksubmitter"
+	"github.com/attestantio/go-block-relay/services/proposerdutiesprovider"
 	"github.com/attestantio/go-block-relay/services/validatorregistrar"
-	"github.com/gin-gonic/gin"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
+	"golang.org/x/crypto/acme/autocert"
 )
 
 // Service is the REST daemon service.
 type Service struct {
-	log                zerolog.Logger
-	srv                *http.Server
-	validatorRegistrar validatorregistrar.Service
-	builderBidProvider builderbidprovider.Service
-	blockUnblinder     blockunblinder.Service
+	log                      zerolog.Logger
+	metrics                  *serviceMetrics
+	srv                      *http.Server
+	certSrv                  *http.Server
+	certificates             *certificateStore
+	maxBidTimeout            time.Duration
+	drainTimeout             time.Duration
+	shutdownDelay            time.Duration
+	maxRequestBodySize       int64
+	maxRegistrationsBodySize int64
+	maxRegistrations         int
+	maxBlindedBlockBodySize  int64
+	registrationsChunkSize   int
+	draining                 atomic.Bool
+	inFlight                 atomic.Int64
+	stopOnce                 sync.Once
+	stopped                  chan struct{}
+	validatorRegistrar       validatorregistrar.Service
+	blockAuctioneer          blockauctioneer.Service
+	auctioneer               blockauctioneer.BlockAuctioneer
+	builderBidProvider       builderbidprovider.Service
+	blockUnblinder           blockunblinder.Service
+	bidTraceRecorder         bidtracerecorder.Service
+	blockSubmitter           builderblocksubmitter.Service
+	dutiesProvider           proposerdutiesprovider.Service
+	payloadProvider          builderbidprovider.ExecutionPayloadProvider
+	payloadRecorder          blockunblinder.PayloadRecorder
+	bidBuilderProvider       builderbidprovider.BidBuilderProvider
 }
 
 // New creates a new REST daemon service.
@@ -55,19 +85,53 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 		log = log.Level(parameters.logLevel)
 	}
 
-	err = registerMetrics(ctx, parameters.monitor)
+	metrics, err := registerMetrics(ctx, parameters.monitor)
 	if err != nil {
 		return nil, errors.New("failed to register metrics")
 	}
 
 	s := &Service{
-		log:                log,
-		validatorRegistrar: parameters.validatorRegistrar,
-		builderBidProvider: parameters.builderBidProvider,
-		blockUnblinder:     parameters.blockUnblinder,
+		log:                      log,
+		metrics:                  metrics,
+		maxBidTimeout:            parameters.maxBidTimeout,
+		drainTimeout:             parameters.drainTimeout,
+		shutdownDelay:            parameters.shutdownDelay,
+		maxRequestBodySize:       parameters.maxRequestBodySize,
+		maxRegistrationsBodySize: parameters.maxRegistrationsBodySize,
+		maxRegistrations:         parameters.maxRegistrations,
+		maxBlindedBlockBodySize:  parameters.maxBlindedBlockBodySize,
+		registrationsChunkSize:   parameters.registrationsChunkSize,
+		stopped:                  make(chan struct{}),
+		validatorRegistrar:       parameters.validatorRegistrar,
+		blockAuctioneer:          parameters.blockAuctioneer,
+		builderBidProvider:       parameters.builderBidProvider,
+		blockUnblinder:           parameters.blockUnblinder,
+		bidTraceRecorder:         parameters.bidTraceRecorder,
+		blockSubmitter:           parameters.blockSubmitter,
+		dutiesProvider:           parameters.dutiesProvider,
 	}
 
-	err = s.startServer(ctx, parameters.serverName, parameters.listenAddress)
+	// Bids are served from auctions if configured, and otherwise from the builder bid provider.
+	var bidSource any = parameters.builderBidProvider
+	if parameters.auctionBids {
+		s.auctioneer = parameters.blockAuctioneer.(blockauctioneer.BlockAuctioneer)
+		bidSource = parameters.blockAuctioneer
+	}
+
+	// Payloads are recorded if the source of bids can supply them and the unblinder can use them.
+	if payloadRecorder, isPayloadRecorder := parameters.blockUnblinder.(blockunblinder.PayloadRecorder); isPayloadRecorder {
+		s.payloadRecorder = payloadRecorder
+		if payloadProvider, isPayloadProvider := bidSource.(builderbidprovider.ExecutionPayloadProvider); isPayloadProvider {
+			s.payloadProvider = payloadProvider
+		}
+	}
+
+	// Served bids are traced with their original builder if the source of bids signs them on the builder's behalf.
+	if bidBuilderProvider, isBidBuilderProvider := bidSource.(builderbidprovider.BidBuilderProvider); isBidBuilderProvider {
+		s.bidBuilderProvider = bidBuilderProvider
+	}
+
+	err = s.startServer(ctx, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -76,105 +140,135 @@ func New(ctx context.Context, params ...Parameter) (*Service, error) {
 }
 
 func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	s.log.Debug().Str("method", r.Method).Stringer("url", r.URL).Msg("Unhandled request")
+	log := loggers.WithRequestID(r.Context(), s.log)
+	log.Debug().Str("method", r.Method).Stringer("url", r.URL).Msg("Unhandled request")
 
 	w.WriteHeader(http.StatusNotFound)
 }
 
 func (s *Service) startServer(ctx context.Context,
-	_ string,
-	listenAddress string,
+	parameters *parameters,
 ) error {
-	// Set to release mode to remove debug logging.
-	gin.SetMode(gin.ReleaseMode)
-
-	// Start up the router.
-	r := gin.New()
-	r.Use(gin.Recovery())
-
-	err := r.SetTrustedProxies(nil)
-	if err != nil {
-		return errors.Wrap(err, "failed to set trusted proxies")
-	}
-
-	r.Use(loggers.NewGinLogger(s.log))
+	listenAddress := parameters.listenAddress
 
 	router := mux.NewRouter()
 	router.HandleFunc("/eth/v1/builder/validators", s.postValidatorRegistrations).Methods("POST")
 	router.HandleFunc("/eth/v1/builder/header/{slot}/{parenthash}/{pubkey}", s.getBuilderBid).Methods("GET")
 	router.HandleFunc("/eth/v1/builder/status", s.getStatus).Methods("GET")
+	router.HandleFunc("/livez", s.getLiveness).Methods("GET")
+	router.HandleFunc("/readyz", s.getReadiness).Methods("GET")
+	router.HandleFunc("/relay/v1/builder/blocks", s.postBuilderBlock).Methods("POST")
+	router.HandleFunc("/relay/v1/builder/validators", s.getBuilderValidators).Methods("GET")
+	router.HandleFunc("/relay/v1/data/bidtraces/proposer_payload_delivered", s.getDeliveredPayloads).Methods("GET")
+	router.HandleFunc("/relay/v1/data/bidtraces/builder_blocks_received", s.getReceivedBids).Methods("GET")
+	router.HandleFunc("/relay/v1/data/validator_registration", s.getValidatorRegistration).Methods("GET")
 	router.HandleFunc("/eth/v1/builder/blinded_blocks", s.postUnblindBlock).Methods("POST")
+	if _, isPublisher := s.blockUnblinder.(blockunblinder.BlockPublisher); isPublisher {
+		// The v2 endpoint is only offered if the unblinder can publish blocks itself, so
+		// that callers fall back to the v1 endpoint.
+		router.HandleFunc("/eth/v2/builder/blinded_blocks", s.postUnblindBlockV2).Methods("POST")
+	}
 	router.PathPrefix("/").Handler(s)
+	router.Use(s.monitorRequests)
+
+	handler, err := s.buildHandler(router, parameters.trustedProxies)
+	if err != nil {
+		return err
+	}
 
 	s.srv = &http.Server{
 		Addr:              listenAddress,
-		Handler:           router,
+		Handler:           handler,
 		ReadHeaderTimeout: 5 * time.Second,
 	}
-	// At current the service does not run over HTTPS.
-	//	if false {
-	//		certManager := autocert.Manager{
-	//			Prompt:     autocert.AcceptTOS,
-	//			HostPolicy: autocert.HostWhitelist(serverName),
-	//			Cache:      autocert.DirCache("./certs"),
-	//		}
-	//
-	//		s.srv.TLSConfig = &tls.Config{
-	//			MinVersion:               tls.VersionTLS13,
-	//			CurvePreferences:         []tls.CurveID{tls.CurveP521, tls.CurveP384, tls.CurveP256},
-	//			GetCertificate:           certManager.GetCertificate,
-	//			PreferServerCipherSuites: true,
-	//			CipherSuites: []uint16{
-	//				tls.TLS_AES_128_GCM_SHA256,
-	//				tls.TLS_CHACHA20_POLY1305_SHA256,
-	//				tls.TLS_AES_256_GCM_SHA384,
-	//				tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
-	//				tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
-	//				tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
-	//				tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
-	//				tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
-	//				tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256,
-	//			},
-	//		}
-	//		s.srv.TLSNextProto = make(map[string]func(*http.Server, *tls.Conn, http.Handler))
-	//
-	//		// Listen on HTTP port for certificate updates.
-	//		go func() {
-	//			s.log.Trace().Str("listen_address", listenAddress).Msg("Starting certificate update service")
-	//			server := &http.Server{
-	//				Addr:              ":http",
-	//				Handler:           certManager.HTTPHandler(nil),
-	//				ReadHeaderTimeout: 5 * time.Second,
-	//			}
-	//			if err := server.ListenAndServe(); err != nil {
-	//				s.log.Error().Err(err).Msg("Certificate update service stopped")
-	//			}
-	//		}()
-	//
-	//		go func() {
-	//			s.log.Trace().Str("listen_address", listenAddress).Msg("Starting HTTPS daemon")
-	//			if err := s.srv.ListenAndServeTLS("", ""); err != http.ErrServerClosed {
-	//				// if err := s.srv.ListenAndServe(); err != http.ErrServerClosed {
-	//				s.log.Error().Err(err).Msg("Server shut down unexpectedly")
-	//			}
-	//		}()
-	//	} else {
-	// Insecure.
-	go func() {
-		s.log.Trace().Str("listen_address", listenAddress).Msg("Starting HTTP daemon")
 
-		err := s.srv.ListenAndServe()
+	switch {
+	case parameters.autoCert:
+		s.startAutoCertServer(parameters)
+	case parameters.serverCertFile != "":
+		err = s.startTLSServer(parameters)
 		if err != nil {
-			s.log.Error().Err(err).Msg("HTTP server shut down")
+			return err
 		}
-	}()
-	// }
+	default:
+		go func() {
+			s.log.Trace().Str("listen_address", listenAddress).Msg("Starting HTTP daemon")
+
+			err := s.srv.ListenAndServe()
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				s.log.Error().Err(err).Msg("HTTP server shut down")
+			}
+		}()
+	}
 
 	go s.sigloop(ctx)
 
 	return nil
 }
 
+// startTLSServer starts the daemon over HTTPS using the supplied certificate and key.
+func (s *Service) startTLSServer(parameters *parameters) error {
+	certificates, err := newCertificateStore(parameters.serverCertFile, parameters.serverKeyFile)
+	if err != nil {
+		return err
+	}
+
+	s.certificates = certificates
+
+	s.srv.TLSConfig = &tls.Config{
+		MinVersion:     tls.VersionTLS13,
+		GetCertificate: certificates.getCertificate,
+	}
+
+	go func() {
+		s.log.Trace().Str("listen_address", parameters.listenAddress).Msg("Starting HTTPS daemon")
+
+		err := s.srv.ListenAndServeTLS("", "")
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.log.Error().Err(err).Msg("HTTPS server shut down")
+		}
+	}()
+
+	return nil
+}
+
+// startAutoCertServer starts the daemon over HTTPS using certificates obtained with ACME.
+func (s *Service) startAutoCertServer(parameters *parameters) {
+	certManager := &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		HostPolicy: autocert.HostWhitelist(parameters.serverName),
+		Cache:      autocert.DirCache(parameters.autoCertCacheDir),
+	}
+
+	s.srv.TLSConfig = certManager.TLSConfig()
+	s.srv.TLSConfig.MinVersion = tls.VersionTLS13
+
+	// Listen on HTTP port for certificate challenges.
+	s.certSrv = &http.Server{
+		Addr:              ":http",
+		Handler:           certManager.HTTPHandler(nil),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		s.log.Trace().Str("listen_address", s.certSrv.Addr).Msg("Starting certificate update service")
+
+		err := s.certSrv.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.log.Error().Err(err).Msg("Certificate update service stopped")
+		}
+	}()
+
+	go func() {
+		s.log.Trace().Str("listen_address", parameters.listenAddress).Msg("Starting HTTPS daemon")
+
+		err := s.srv.ListenAndServeTLS("", "")
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.log.Error().Err(err).Msg("HTTPS server shut down")
+		}
+	}()
+}
+
 func (s *Service) obtainContentType(_ context.Context,
 	r *http.Request,
 ) string {
@@ -183,7 +277,7 @@ func (s *Service) obtainContentType(_ context.Context,
 	var contentType string
 	if !exists {
 		// Assume that no content type == JSON, for backwards-compatibility.
-		contentType = "application/json"
+		contentType = contentTypeJSON
 	} else {
 		contentType = contentTypeHeaderVals[0]
 	}
@@ -199,32 +293,112 @@ func (s *Service) obtainContentType(_ context.Context,
 	return contentType
 }
 
+// Stop gracefully stops the service, waiting for in-flight requests to complete.
+// It returns an error if the context is done before the service has stopped,
+// in which case the service continues to stop in the background.
+func (s *Service) Stop(ctx context.Context) error {
+	go s.shutdown()
+
+	select {
+	case <-s.stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Wait blocks until the service has stopped.
+func (s *Service) Wait() {
+	<-s.stopped
+}
+
 func (s *Service) sigloop(ctx context.Context) {
 	sigCh := make(chan os.Signal, 1)
-	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM, os.Interrupt)
+	signals := []os.Signal{syscall.SIGINT, syscall.SIGTERM, os.Interrupt}
+	if s.certificates != nil {
+		// Only claim SIGHUP when there is a certificate to reload, leaving its default behaviour otherwise.
+		signals = append(signals, syscall.SIGHUP)
+	}
+	signal.Notify(sigCh, signals...)
+	defer signal.Stop(sigCh)
 
 	for {
 		select {
 		case sig := <-sigCh:
+			if sig == syscall.SIGHUP {
+				s.reloadCertificates()
+
+				continue
+			}
+
 			if sig == syscall.SIGINT || sig == syscall.SIGTERM || sig == os.Interrupt || sig == os.Kill {
 				s.log.Info().Msg("Received signal, shutting down")
-
-				err := s.srv.Shutdown(ctx)
-				if err != nil {
-					s.log.Warn().Err(err).Msg("Failed to shutdown service")
-				}
+				s.shutdown()
 
 				return
 			}
 		case <-ctx.Done():
 			s.log.Info().Msg("Context done, shutting down")
+			s.shutdown()
+
+			return
+		case <-s.stopped:
+			return
+		}
+	}
+}
+
+// shutdown gracefully shuts down the servers.
+// It is safe to call multiple times; subsequent calls return once the first has completed.
+func (s *Service) shutdown() {
+	s.stopOnce.Do(func() {
+		defer close(s.stopped)
+
+		// Report as unavailable so that callers stop sending requests before the listener closes.
+		s.draining.Store(true)
+		if s.shutdownDelay > 0 {
+			s.log.Trace().Dur("delay", s.shutdownDelay).Msg("Delaying shutdown")
+			time.Sleep(s.shutdownDelay)
+		}
 
-			err := s.srv.Shutdown(ctx)
+		// The context that triggered shutdown may already be done, so use a fresh one to drain requests.
+		ctx, cancel := context.WithTimeout(context.Background(), s.drainTimeout)
+		defer cancel()
+
+		s.log.Trace().Int64("in_flight", s.inFlight.Load()).Dur("timeout", s.drainTimeout).Msg("Draining requests")
+
+		err := s.srv.Shutdown(ctx)
+		if err != nil {
+			s.log.Warn().Err(err).Int64("in_flight", s.inFlight.Load()).Msg("Failed to drain requests; closing service")
+
+			err = s.srv.Close()
 			if err != nil {
-				s.log.Warn().Err(err).Msg("Failed to shutdown service")
+				s.log.Warn().Err(err).Msg("Failed to close service")
 			}
+		}
 
-			return
+		if s.certSrv != nil {
+			err = s.certSrv.Shutdown(ctx)
+			if err != nil {
+				s.log.Warn().Err(err).Msg("Failed to shutdown certificate update service")
+			}
 		}
+
+		s.log.Trace().Msg("Shut down")
+	})
+}
+
+// reloadCertificates reloads the server certificate from disk.
+// It is only called when the daemon is using a server certificate.
+func (s *Service) reloadCertificates() {
+	s.log.Info().Msg("Received signal, reloading server certificate")
+
+	err := s.certificates.reload()
+	if err != nil {
+		s.log.Error().Err(err).Msg("Failed to reload server certificate; retaining existing certificate")
+
+		return
 	}
+
+	s.log.Info().Msg("Reloaded server certificate")
 }
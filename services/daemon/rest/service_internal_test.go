@@ -0,0 +1,155 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// startSlowService starts a service with an endpoint that blocks until released.
+func startSlowService(t *testing.T,
+	drainTimeout time.Duration,
+) (
+	*Service,
+	string,
+	chan struct{},
+	chan struct{},
+) {
+	t.Helper()
+
+	s := &Service{
+		log:          zerolog.Nop(),
+		drainTimeout: drainTimeout,
+		stopped:      make(chan struct{}),
+	}
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	router := mux.NewRouter()
+	router.HandleFunc("/slow", func(w http.ResponseWriter, _ *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusOK)
+	})
+	router.Use(s.monitorRequests)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s.srv = &http.Server{
+		Handler:           router,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	go func() {
+		_ = s.srv.Serve(listener)
+	}()
+
+	return s, "http://" + listener.Addr().String(), started, release
+}
+
+func TestStopDrainsRequests(t *testing.T) {
+	ctx := context.Background()
+
+	s, address, started, release := startSlowService(t, time.Second)
+
+	respCh := make(chan int, 1)
+	go func() {
+		resp, err := http.Get(address + "/slow")
+		if err != nil {
+			respCh <- 0
+
+			return
+		}
+		resp.Body.Close()
+		respCh <- resp.StatusCode
+	}()
+
+	<-started
+	require.Equal(t, int64(1), s.inFlight.Load())
+
+	stopErrCh := make(chan error, 1)
+	go func() {
+		stopErrCh <- s.Stop(ctx)
+	}()
+
+	// Service reports as unavailable while draining.
+	require.Eventually(t, s.draining.Load, time.Second, 10*time.Millisecond)
+	writer := httptest.NewRecorder()
+	s.getStatus(writer, httptest.NewRequest(http.MethodGet, "/eth/v1/builder/status", nil))
+	require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+
+	// Service does not stop while the request is in flight.
+	select {
+	case <-s.stopped:
+		require.Fail(t, "service stopped with request in flight")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(release)
+	require.Equal(t, http.StatusOK, <-respCh)
+	require.NoError(t, <-stopErrCh)
+	require.Equal(t, int64(0), s.inFlight.Load())
+
+	// Wait returns immediately for a stopped service, and stopping again is harmless.
+	s.Wait()
+	require.NoError(t, s.Stop(ctx))
+}
+
+func TestStopDrainTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	s, address, started, release := startSlowService(t, 100*time.Millisecond)
+	defer close(release)
+
+	go func() {
+		resp, err := http.Get(address + "/slow")
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+
+	<-started
+
+	stopped := time.Now()
+	require.NoError(t, s.Stop(ctx))
+	require.Less(t, time.Since(stopped), time.Second)
+}
+
+func TestStopContextDone(t *testing.T) {
+	s, address, started, release := startSlowService(t, time.Second)
+	defer close(release)
+
+	go func() {
+		resp, err := http.Get(address + "/slow")
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, s.Stop(ctx), context.DeadlineExceeded)
+}
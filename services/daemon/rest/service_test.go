@@ -1,4 +1,4 @@
-// Copyright © 2022, 2024 Attestant Limited.
+// Copyright © 2022 - 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -115,6 +115,246 @@ func TestService(t *testing.T) {
 			},
 			err: "problem with parameters: no block unblinder specified",
 		},
+		{
+			name: "ServerKeyFileMissing",
+			params: []restdaemon.Parameter{
+				restdaemon.WithLogLevel(zerolog.Disabled),
+				restdaemon.WithMonitor(monitor),
+				restdaemon.WithListenAddress(":14734"),
+				restdaemon.WithServerCertFile("server.crt"),
+				restdaemon.WithValidatorRegistrar(registrar),
+				restdaemon.WithBlockAuctioneer(auctioneer),
+				restdaemon.WithBlockUnblinder(unblinder),
+				restdaemon.WithBuilderBidProvider(builderBidProvider),
+			},
+			err: "problem with parameters: server certificate file specified without server key file",
+		},
+		{
+			name: "ServerCertFileMissing",
+			params: []restdaemon.Parameter{
+				restdaemon.WithLogLevel(zerolog.Disabled),
+				restdaemon.WithMonitor(monitor),
+				restdaemon.WithListenAddress(":14734"),
+				restdaemon.WithServerKeyFile("server.key"),
+				restdaemon.WithValidatorRegistrar(registrar),
+				restdaemon.WithBlockAuctioneer(auctioneer),
+				restdaemon.WithBlockUnblinder(unblinder),
+				restdaemon.WithBuilderBidProvider(builderBidProvider),
+			},
+			err: "problem with parameters: server key file specified without server certificate file",
+		},
+		{
+			name: "ServerCertFileInvalid",
+			params: []restdaemon.Parameter{
+				restdaemon.WithLogLevel(zerolog.Disabled),
+				restdaemon.WithMonitor(monitor),
+				restdaemon.WithListenAddress(":14734"),
+				restdaemon.WithServerCertFile("missing.crt"),
+				restdaemon.WithServerKeyFile("missing.key"),
+				restdaemon.WithValidatorRegistrar(registrar),
+				restdaemon.WithBlockAuctioneer(auctioneer),
+				restdaemon.WithBlockUnblinder(unblinder),
+				restdaemon.WithBuilderBidProvider(builderBidProvider),
+			},
+			err: "failed to load server certificate: open missing.crt: no such file or directory",
+		},
+		{
+			name: "AutoCertWithServerCertFile",
+			params: []restdaemon.Parameter{
+				restdaemon.WithLogLevel(zerolog.Disabled),
+				restdaemon.WithMonitor(monitor),
+				restdaemon.WithListenAddress(":14734"),
+				restdaemon.WithServerName("server.attestant.io"),
+				restdaemon.WithAutoCert(true),
+				restdaemon.WithServerCertFile("server.crt"),
+				restdaemon.WithServerKeyFile("server.key"),
+				restdaemon.WithValidatorRegistrar(registrar),
+				restdaemon.WithBlockAuctioneer(auctioneer),
+				restdaemon.WithBlockUnblinder(unblinder),
+				restdaemon.WithBuilderBidProvider(builderBidProvider),
+			},
+			err: "problem with parameters: cannot use both automatic certificates and a server certificate file",
+		},
+		{
+			name: "AutoCertServerNameMissing",
+			params: []restdaemon.Parameter{
+				restdaemon.WithLogLevel(zerolog.Disabled),
+				restdaemon.WithMonitor(monitor),
+				restdaemon.WithListenAddress(":14734"),
+				restdaemon.WithAutoCert(true),
+				restdaemon.WithValidatorRegistrar(registrar),
+				restdaemon.WithBlockAuctioneer(auctioneer),
+				restdaemon.WithBlockUnblinder(unblinder),
+				restdaemon.WithBuilderBidProvider(builderBidProvider),
+			},
+			err: "problem with parameters: no server name specified",
+		},
+		{
+			name: "AutoCertCacheDirMissing",
+			params: []restdaemon.Parameter{
+				restdaemon.WithLogLevel(zerolog.Disabled),
+				restdaemon.WithMonitor(monitor),
+				restdaemon.WithListenAddress(":14734"),
+				restdaemon.WithServerName("server.attestant.io"),
+				restdaemon.WithAutoCert(true),
+				restdaemon.WithAutoCertCacheDir(""),
+				restdaemon.WithValidatorRegistrar(registrar),
+				restdaemon.WithBlockAuctioneer(auctioneer),
+				restdaemon.WithBlockUnblinder(unblinder),
+				restdaemon.WithBuilderBidProvider(builderBidProvider),
+			},
+			err: "problem with parameters: no automatic certificate cache directory specified",
+		},
+		{
+			name: "MaxBidTimeoutZero",
+			params: []restdaemon.Parameter{
+				restdaemon.WithLogLevel(zerolog.Disabled),
+				restdaemon.WithMonitor(monitor),
+				restdaemon.WithListenAddress(":14734"),
+				restdaemon.WithMaxBidTimeout(0),
+				restdaemon.WithValidatorRegistrar(registrar),
+				restdaemon.WithBlockAuctioneer(auctioneer),
+				restdaemon.WithBlockUnblinder(unblinder),
+				restdaemon.WithBuilderBidProvider(builderBidProvider),
+			},
+			err: "problem with parameters: max bid timeout must be greater than 0",
+		},
+		{
+			name: "DrainTimeoutZero",
+			params: []restdaemon.Parameter{
+				restdaemon.WithLogLevel(zerolog.Disabled),
+				restdaemon.WithMonitor(monitor),
+				restdaemon.WithListenAddress(":14734"),
+				restdaemon.WithDrainTimeout(0),
+				restdaemon.WithValidatorRegistrar(registrar),
+				restdaemon.WithBlockAuctioneer(auctioneer),
+				restdaemon.WithBlockUnblinder(unblinder),
+				restdaemon.WithBuilderBidProvider(builderBidProvider),
+			},
+			err: "problem with parameters: drain timeout must be greater than 0",
+		},
+		{
+			name: "ShutdownDelayNegative",
+			params: []restdaemon.Parameter{
+				restdaemon.WithLogLevel(zerolog.Disabled),
+				restdaemon.WithMonitor(monitor),
+				restdaemon.WithListenAddress(":14734"),
+				restdaemon.WithShutdownDelay(-time.Second),
+				restdaemon.WithValidatorRegistrar(registrar),
+				restdaemon.WithBlockAuctioneer(auctioneer),
+				restdaemon.WithBlockUnblinder(unblinder),
+				restdaemon.WithBuilderBidProvider(builderBidProvider),
+			},
+			err: "problem with parameters: shutdown delay cannot be negative",
+		},
+		{
+			name: "AuctioneerCannotAuction",
+			params: []restdaemon.Parameter{
+				restdaemon.WithLogLevel(zerolog.Disabled),
+				restdaemon.WithMonitor(monitor),
+				restdaemon.WithListenAddress(":14734"),
+				restdaemon.WithValidatorRegistrar(registrar),
+				restdaemon.WithBlockAuctioneer(struct{}{}),
+				restdaemon.WithAuctionBids(true),
+				restdaemon.WithBlockUnblinder(unblinder),
+				restdaemon.WithBuilderBidProvider(builderBidProvider),
+			},
+			err: "problem with parameters: block auctioneer cannot run auctions; auction bids require a block auctioneer that can",
+		},
+		{
+			name: "AuctionBidsDefaultDisabled",
+			params: []restdaemon.Parameter{
+				restdaemon.WithLogLevel(zerolog.Disabled),
+				restdaemon.WithMonitor(monitor),
+				restdaemon.WithListenAddress(":14734"),
+				restdaemon.WithValidatorRegistrar(registrar),
+				restdaemon.WithBlockAuctioneer(struct{}{}),
+				restdaemon.WithBlockUnblinder(unblinder),
+				restdaemon.WithBuilderBidProvider(builderBidProvider),
+			},
+		},
+		{
+			name: "AuctionBidsWithoutBuilderBidProvider",
+			params: []restdaemon.Parameter{
+				restdaemon.WithLogLevel(zerolog.Disabled),
+				restdaemon.WithMonitor(monitor),
+				restdaemon.WithListenAddress(":14734"),
+				restdaemon.WithValidatorRegistrar(registrar),
+				restdaemon.WithBlockAuctioneer(auctioneer),
+				restdaemon.WithAuctionBids(true),
+				restdaemon.WithBlockUnblinder(unblinder),
+			},
+		},
+		{
+			name: "MaxRequestBodySizeZero",
+			params: []restdaemon.Parameter{
+				restdaemon.WithLogLevel(zerolog.Disabled),
+				restdaemon.WithMonitor(monitor),
+				restdaemon.WithListenAddress(":14734"),
+				restdaemon.WithMaxRequestBodySize(0),
+				restdaemon.WithValidatorRegistrar(registrar),
+				restdaemon.WithBlockAuctioneer(auctioneer),
+				restdaemon.WithBlockUnblinder(unblinder),
+				restdaemon.WithBuilderBidProvider(builderBidProvider),
+			},
+			err: "problem with parameters: max request body size must be greater than 0",
+		},
+		{
+			name: "MaxRegistrationsBodySizeZero",
+			params: []restdaemon.Parameter{
+				restdaemon.WithLogLevel(zerolog.Disabled),
+				restdaemon.WithMonitor(monitor),
+				restdaemon.WithListenAddress(":14734"),
+				restdaemon.WithMaxRegistrationsBodySize(0),
+				restdaemon.WithValidatorRegistrar(registrar),
+				restdaemon.WithBlockAuctioneer(auctioneer),
+				restdaemon.WithBlockUnblinder(unblinder),
+				restdaemon.WithBuilderBidProvider(builderBidProvider),
+			},
+			err: "problem with parameters: max registrations body size must be greater than 0",
+		},
+		{
+			name: "MaxRegistrationsZero",
+			params: []restdaemon.Parameter{
+				restdaemon.WithLogLevel(zerolog.Disabled),
+				restdaemon.WithMonitor(monitor),
+				restdaemon.WithListenAddress(":14734"),
+				restdaemon.WithMaxRegistrations(0),
+				restdaemon.WithValidatorRegistrar(registrar),
+				restdaemon.WithBlockAuctioneer(auctioneer),
+				restdaemon.WithBlockUnblinder(unblinder),
+				restdaemon.WithBuilderBidProvider(builderBidProvider),
+			},
+			err: "problem with parameters: max registrations must be greater than 0",
+		},
+		{
+			name: "MaxBlindedBlockBodySizeZero",
+			params: []restdaemon.Parameter{
+				restdaemon.WithLogLevel(zerolog.Disabled),
+				restdaemon.WithMonitor(monitor),
+				restdaemon.WithListenAddress(":14734"),
+				restdaemon.WithMaxBlindedBlockBodySize(0),
+				restdaemon.WithValidatorRegistrar(registrar),
+				restdaemon.WithBlockAuctioneer(auctioneer),
+				restdaemon.WithBlockUnblinder(unblinder),
+				restdaemon.WithBuilderBidProvider(builderBidProvider),
+			},
+			err: "problem with parameters: max blinded block body size must be greater than 0",
+		},
+		{
+			name: "RegistrationsChunkSizeNegative",
+			params: []restdaemon.Parameter{
+				restdaemon.WithLogLevel(zerolog.Disabled),
+				restdaemon.WithMonitor(monitor),
+				restdaemon.WithListenAddress(":14734"),
+				restdaemon.WithRegistrationsChunkSize(-1),
+				restdaemon.WithValidatorRegistrar(registrar),
+				restdaemon.WithBlockAuctioneer(auctioneer),
+				restdaemon.WithBlockUnblinder(unblinder),
+				restdaemon.WithBuilderBidProvider(builderBidProvider),
+			},
+			err: "problem with parameters: registrations chunk size cannot be negative",
+		},
 		{
 			name: "Good",
 			params: []restdaemon.Parameter{
@@ -1,4 +1,4 @@
-// Copyright © 2022, 2023 Attestant Limited.
+// Copyright © 2022 - 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -14,10 +14,135 @@
 package rest
 
 import (
+	"context"
 	"net/http"
+
+	"github.com/attestantio/go-block-relay/loggers"
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+	"github.com/attestantio/go-block-relay/services/blockunblinder"
+	"github.com/attestantio/go-block-relay/services/builderbidprovider"
+	"github.com/attestantio/go-block-relay/services/validatorregistrar"
+)
+
+const (
+	healthStatusOK          = "ok"
+	healthStatusUnavailable = "unavailable"
+
+	componentHealthy   = "healthy"
+	componentUnhealthy = "unhealthy"
+	componentUnknown   = "unknown"
+	componentDraining  = "draining"
 )
 
-func (s *Service) getStatus(w http.ResponseWriter, _ *http.Request) {
-	s.log.Trace().Msg("Status requested")
+// HealthResponse is the response to a liveness or readiness request.
+type HealthResponse struct {
+	Status     string            `json:"status"`
+	Components map[string]string `json:"components"`
+}
+
+func (s *Service) getStatus(w http.ResponseWriter, r *http.Request) {
+	log := loggers.WithRequestID(r.Context(), s.log)
+	log.Trace().Msg("Status requested")
+
+	if _, ready := s.readiness(r.Context()); !ready {
+		// Report as unavailable so that callers go elsewhere.
+		w.WriteHeader(http.StatusServiceUnavailable)
+
+		return
+	}
+
 	w.WriteHeader(http.StatusOK)
 }
+
+// getLiveness reports if the service is running.
+// Components are reported for information, but do not affect the result.
+func (s *Service) getLiveness(w http.ResponseWriter, r *http.Request) {
+	log := loggers.WithRequestID(r.Context(), s.log)
+	log.Trace().Msg("Liveness requested")
+
+	s.sendResponse(r.Context(), w, http.StatusOK, nil, &HealthResponse{
+		Status:     healthStatusOK,
+		Components: s.componentHealth(r.Context()),
+	})
+}
+
+// getReadiness reports if the service is ready to handle requests.
+func (s *Service) getReadiness(w http.ResponseWriter, r *http.Request) {
+	log := loggers.WithRequestID(r.Context(), s.log)
+	log.Trace().Msg("Readiness requested")
+
+	components, ready := s.readiness(r.Context())
+	if !ready {
+		s.sendResponse(r.Context(), w, http.StatusServiceUnavailable, nil, &HealthResponse{
+			Status:     healthStatusUnavailable,
+			Components: components,
+		})
+
+		return
+	}
+
+	s.sendResponse(r.Context(), w, http.StatusOK, nil, &HealthResponse{
+		Status:     healthStatusOK,
+		Components: components,
+	})
+}
+
+// readiness returns the health of the service's components, and if the service
+// is ready to handle requests.
+// The service is not ready if it is draining or any of its components are unhealthy.
+func (s *Service) readiness(ctx context.Context) (map[string]string, bool) {
+	components := s.componentHealth(ctx)
+
+	ready := true
+	for _, health := range components {
+		if health == componentUnhealthy {
+			ready = false
+		}
+	}
+
+	if s.draining.Load() {
+		components["daemon"] = componentDraining
+		ready = false
+	} else {
+		components["daemon"] = componentHealthy
+	}
+
+	return components, ready
+}
+
+// componentHealth returns the health of each of the service's components.
+// Components that cannot report their health are assumed to be healthy, and
+// are reported as unknown.
+func (s *Service) componentHealth(ctx context.Context) map[string]string {
+	components := make(map[string]string, 5)
+
+	components["builder_bid_provider"] = componentUnknown
+	if reporter, isReporter := s.builderBidProvider.(builderbidprovider.HealthReporter); isReporter {
+		components["builder_bid_provider"] = healthString(reporter.Healthy(ctx))
+	}
+
+	components["block_auctioneer"] = componentUnknown
+	if reporter, isReporter := s.blockAuctioneer.(blockauctioneer.HealthReporter); isReporter {
+		components["block_auctioneer"] = healthString(reporter.Healthy(ctx))
+	}
+
+	components["block_unblinder"] = componentUnknown
+	if reporter, isReporter := s.blockUnblinder.(blockunblinder.HealthReporter); isReporter {
+		components["block_unblinder"] = healthString(reporter.Healthy(ctx))
+	}
+
+	components["validator_registrar"] = componentUnknown
+	if reporter, isReporter := s.validatorRegistrar.(validatorregistrar.HealthReporter); isReporter {
+		components["validator_registrar"] = healthString(reporter.Healthy(ctx))
+	}
+
+	return components
+}
+
+func healthString(healthy bool) string {
+	if healthy {
+		return componentHealthy
+	}
+
+	return componentUnhealthy
+}
@@ -0,0 +1,192 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-block-relay/services/blockauctioneer"
+	mockblockauctioneer "github.com/attestantio/go-block-relay/services/blockauctioneer/mock"
+	"github.com/attestantio/go-block-relay/services/blockunblinder"
+	mockblockunblinder "github.com/attestantio/go-block-relay/services/blockunblinder/mock"
+	"github.com/attestantio/go-block-relay/services/builderbidprovider"
+	mockbuilderbidprovider "github.com/attestantio/go-block-relay/services/builderbidprovider/mock"
+	"github.com/attestantio/go-block-relay/services/validatorregistrar"
+	mockvalidatorregistrar "github.com/attestantio/go-block-relay/services/validatorregistrar/mock"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealth(t *testing.T) {
+	tests := []struct {
+		name               string
+		builderBidProvider builderbidprovider.Service
+		blockAuctioneer    blockauctioneer.Service
+		blockUnblinder     blockunblinder.Service
+		validatorRegistrar validatorregistrar.Service
+		draining           bool
+		statusCode         int
+		components         map[string]string
+	}{
+		{
+			name:               "Healthy",
+			builderBidProvider: mockbuilderbidprovider.New(),
+			blockAuctioneer:    mockblockauctioneer.New(),
+			blockUnblinder:     mockblockunblinder.New(),
+			validatorRegistrar: mockvalidatorregistrar.New(),
+			statusCode:         http.StatusOK,
+			components: map[string]string{
+				"builder_bid_provider": "healthy",
+				"block_auctioneer":     "healthy",
+				"block_unblinder":      "healthy",
+				"validator_registrar":  "healthy",
+				"daemon":               "healthy",
+			},
+		},
+		{
+			name:               "Unknown",
+			builderBidProvider: mockbuilderbidprovider.New(),
+			blockAuctioneer:    mockblockauctioneer.New(),
+			blockUnblinder:     mockblockunblinder.New(),
+			validatorRegistrar: struct{}{},
+			statusCode:         http.StatusOK,
+			components: map[string]string{
+				"builder_bid_provider": "healthy",
+				"block_auctioneer":     "healthy",
+				"block_unblinder":      "healthy",
+				"validator_registrar":  "unknown",
+				"daemon":               "healthy",
+			},
+		},
+		{
+			name:               "BuilderBidProviderUnhealthy",
+			builderBidProvider: mockbuilderbidprovider.NewErroring(),
+			blockAuctioneer:    mockblockauctioneer.New(),
+			blockUnblinder:     mockblockunblinder.New(),
+			validatorRegistrar: mockvalidatorregistrar.New(),
+			statusCode:         http.StatusServiceUnavailable,
+			components: map[string]string{
+				"builder_bid_provider": "unhealthy",
+				"block_auctioneer":     "healthy",
+				"block_unblinder":      "healthy",
+				"validator_registrar":  "healthy",
+				"daemon":               "healthy",
+			},
+		},
+		{
+			name:               "BlockAuctioneerUnhealthy",
+			builderBidProvider: mockbuilderbidprovider.New(),
+			blockAuctioneer:    mockblockauctioneer.NewErroring(),
+			blockUnblinder:     mockblockunblinder.New(),
+			validatorRegistrar: mockvalidatorregistrar.New(),
+			statusCode:         http.StatusServiceUnavailable,
+			components: map[string]string{
+				"builder_bid_provider": "healthy",
+				"block_auctioneer":     "unhealthy",
+				"block_unblinder":      "healthy",
+				"validator_registrar":  "healthy",
+				"daemon":               "healthy",
+			},
+		},
+		{
+			name:               "BlockUnblinderUnhealthy",
+			builderBidProvider: mockbuilderbidprovider.New(),
+			blockAuctioneer:    mockblockauctioneer.New(),
+			blockUnblinder:     mockblockunblinder.NewErroring(),
+			validatorRegistrar: mockvalidatorregistrar.New(),
+			statusCode:         http.StatusServiceUnavailable,
+			components: map[string]string{
+				"builder_bid_provider": "healthy",
+				"block_auctioneer":     "healthy",
+				"block_unblinder":      "unhealthy",
+				"validator_registrar":  "healthy",
+				"daemon":               "healthy",
+			},
+		},
+		{
+			name:               "ValidatorRegistrarUnhealthy",
+			builderBidProvider: mockbuilderbidprovider.New(),
+			blockAuctioneer:    mockblockauctioneer.New(),
+			blockUnblinder:     mockblockunblinder.New(),
+			validatorRegistrar: mockvalidatorregistrar.NewErroring(),
+			statusCode:         http.StatusServiceUnavailable,
+			components: map[string]string{
+				"builder_bid_provider": "healthy",
+				"block_auctioneer":     "healthy",
+				"block_unblinder":      "healthy",
+				"validator_registrar":  "unhealthy",
+				"daemon":               "healthy",
+			},
+		},
+		{
+			name:               "Draining",
+			builderBidProvider: mockbuilderbidprovider.New(),
+			blockAuctioneer:    mockblockauctioneer.New(),
+			blockUnblinder:     mockblockunblinder.New(),
+			validatorRegistrar: mockvalidatorregistrar.New(),
+			draining:           true,
+			statusCode:         http.StatusServiceUnavailable,
+			components: map[string]string{
+				"builder_bid_provider": "healthy",
+				"block_auctioneer":     "healthy",
+				"block_unblinder":      "healthy",
+				"validator_registrar":  "healthy",
+				"daemon":               "draining",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				log:                zerolog.Nop(),
+				builderBidProvider: test.builderBidProvider,
+				blockAuctioneer:    test.blockAuctioneer,
+				blockUnblinder:     test.blockUnblinder,
+				validatorRegistrar: test.validatorRegistrar,
+			}
+			s.draining.Store(test.draining)
+
+			// Status.
+			writer := httptest.NewRecorder()
+			s.getStatus(writer, httptest.NewRequest(http.MethodGet, "/eth/v1/builder/status", nil))
+			require.Equal(t, test.statusCode, writer.Code)
+
+			// Readiness.
+			writer = httptest.NewRecorder()
+			s.getReadiness(writer, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+			require.Equal(t, test.statusCode, writer.Code)
+			resp := &HealthResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+			if test.statusCode == http.StatusOK {
+				require.Equal(t, "ok", resp.Status)
+			} else {
+				require.Equal(t, "unavailable", resp.Status)
+			}
+			require.Equal(t, test.components, resp.Components)
+
+			// Liveness is unaffected by component health.
+			writer = httptest.NewRecorder()
+			s.getLiveness(writer, httptest.NewRequest(http.MethodGet, "/livez", nil))
+			require.Equal(t, http.StatusOK, writer.Code)
+			resp = &HealthResponse{}
+			require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+			require.Equal(t, "ok", resp.Status)
+			require.Len(t, resp.Components, 4)
+		})
+	}
+}
@@ -1,4 +1,4 @@
-// Copyright © 2024, 2025 Attestant Limited.
+// Copyright © 2024 - 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -22,6 +22,9 @@ import (
 	"strings"
 
 	relay "github.com/attestantio/go-block-relay"
+	"github.com/attestantio/go-block-relay/loggers"
+	builderapideneb "github.com/attestantio/go-builder-client/api/deneb"
+	builderapifulu "github.com/attestantio/go-builder-client/api/fulu"
 	"github.com/attestantio/go-eth2-client/api"
 	apiv1bellatrix "github.com/attestantio/go-eth2-client/api/v1/bellatrix"
 	apiv1capella "github.com/attestantio/go-eth2-client/api/v1/capella"
@@ -30,24 +33,73 @@ import (
 	"github.com/attestantio/go-eth2-client/spec"
 	"github.com/attestantio/go-eth2-client/spec/deneb"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func (s *Service) postUnblindBlock(w http.ResponseWriter, r *http.Request) {
-	s.log.Trace().Msg("unblindBlock called")
+	log := loggers.WithRequestID(r.Context(), s.log)
+	log.Trace().Msg("unblindBlock called")
 
 	ctx := r.Context()
 
+	contentType, err := s.obtainAcceptedContentType(ctx, r, contentTypeJSON, contentTypeSSZ)
+	if err != nil {
+		log.Debug().Err(err).Msg("Unsupported accept header")
+		s.sendResponse(r.Context(), w,
+			http.StatusNotAcceptable,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusNotAcceptable,
+				Message: err.Error(),
+			})
+		s.monitorRequestHandled("unblind block", "failure")
+
+		return
+	}
+
+	err = s.decodeRequestBody(w, r, s.maxBlindedBlockBodySize)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to decode request body")
+		statusCode := requestBodyErrorStatus(err)
+		s.sendResponse(r.Context(), w,
+			statusCode,
+			map[string]string{},
+			&APIResponse{
+				Code:    statusCode,
+				Message: err.Error(),
+			})
+		s.monitorRequestHandled("unblind block", "failure")
+
+		return
+	}
+
 	signedBlindedBeaconBlock, err := s.obtainUnblindedBlock(ctx, r)
 	if err != nil {
-		s.log.Error().Err(err).Msg("Unable to obtain unblinded block")
-		s.sendResponse(w,
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			log.Debug().Err(err).Msg("Blinded block too large")
+			s.sendResponse(r.Context(), w,
+				http.StatusRequestEntityTooLarge,
+				map[string]string{},
+				&APIResponse{
+					Code:    http.StatusRequestEntityTooLarge,
+					Message: maxBytesErr.Error(),
+				})
+			s.monitorRequestHandled("unblind block", "failure")
+
+			return
+		}
+
+		log.Error().Err(err).Msg("Unable to obtain unblinded block")
+		s.sendResponse(r.Context(), w,
 			http.StatusInternalServerError,
 			map[string]string{},
 			&APIResponse{
 				Code:    http.StatusBadRequest,
 				Message: "Unable to obtain blinded block",
 			})
-		monitorRequestHandled("unblind block", "failure")
+		s.monitorRequestHandled("unblind block", "failure")
 
 		return
 	}
@@ -59,66 +111,113 @@ func (s *Service) postUnblindBlock(w http.ResponseWriter, r *http.Request) {
 			code = http.StatusBadRequest
 		}
 
-		s.log.Error().Err(err).Msg("Failed to unblind block")
-		s.sendResponse(w,
+		log.Error().Err(err).Msg("Failed to unblind block")
+		s.sendResponse(r.Context(), w,
 			http.StatusInternalServerError,
 			map[string]string{},
 			&APIResponse{
 				Code:    code,
 				Message: "Failed to unblind block",
 			})
-		monitorRequestHandled("unblind block", "failure")
+		s.monitorRequestHandled("unblind block", "failure")
 
 		return
 	}
 
 	if signedProposal == nil {
-		s.sendResponse(w,
+		s.sendResponse(r.Context(), w,
 			http.StatusNoContent,
 			map[string]string{},
 			nil,
 		)
-		monitorRequestHandled("unblind block", "success")
+		s.monitorRequestHandled("unblind block", "success")
 
 		return
 	}
 
 	data, err := s.outputUnblindedBlock(ctx, signedProposal)
 	if err != nil {
-		s.log.Error().Err(err).Msg("Failed to generate output")
-		s.sendResponse(w,
+		log.Error().Err(err).Msg("Failed to generate output")
+		s.sendResponse(r.Context(), w,
 			http.StatusInternalServerError,
 			map[string]string{},
 			&APIResponse{
 				Code:    http.StatusInternalServerError,
 				Message: "Failed to unblind block",
 			})
-		monitorRequestHandled("unblind block", "failure")
+		s.monitorRequestHandled("unblind block", "failure")
 
 		return
 	}
 
-	monitorRequestHandled("unblind block", "success")
-
 	headers := map[string]string{}
 	headers[EthConsensusVersion] = data.Version.String()
-	s.sendResponse(w,
+
+	if contentType == contentTypeSSZ {
+		sszData, err := s.marshalUnblindedBlockSSZ(ctx, data)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to generate SSZ output")
+			s.sendResponse(r.Context(), w,
+				http.StatusInternalServerError,
+				map[string]string{},
+				&APIResponse{
+					Code:    http.StatusInternalServerError,
+					Message: "Failed to unblind block",
+				})
+			s.monitorRequestHandled("unblind block", "failure")
+
+			return
+		}
+
+		// The payload is only recorded as delivered once it is certain to be returned.
+		s.recordDeliveredPayload(ctx, signedProposal)
+		s.monitorRequestHandled("unblind block", "success")
+		s.sendSSZResponse(r.Context(), w,
+			http.StatusOK,
+			headers,
+			sszData,
+		)
+
+		return
+	}
+
+	s.recordDeliveredPayload(ctx, signedProposal)
+	s.monitorRequestHandled("unblind block", "success")
+	s.sendResponse(r.Context(), w,
 		http.StatusOK,
 		headers,
 		data,
 	)
 }
 
+// recordDeliveredPayload records the delivery of a payload with the bid trace recorder, if present.
+// Failure to record is not fatal, as it only affects the relay data API.
+func (s *Service) recordDeliveredPayload(ctx context.Context,
+	signedProposal *api.VersionedSignedProposal,
+) {
+	if s.bidTraceRecorder == nil {
+		return
+	}
+
+	err := s.bidTraceRecorder.RecordDeliveredPayload(ctx, signedProposal)
+	if err != nil {
+		log := loggers.WithRequestID(ctx, s.log)
+		log.Warn().Err(err).Msg("Failed to record delivered payload")
+	}
+}
+
 func (s *Service) obtainUnblindedBlock(ctx context.Context,
 	r *http.Request,
 ) (
 	*api.VersionedSignedBlindedBeaconBlock,
 	error,
 ) {
+	log := loggers.WithRequestID(ctx, s.log)
+
 	contentType := s.obtainContentType(ctx, r)
 
 	for k, v := range r.Header {
-		s.log.Trace().Str("key", k).Strs("values", v).Msg("Header")
+		log.Trace().Str("key", k).Strs("values", v).Msg("Header")
 	}
 
 	// Obtain the consensus version so we know what we have to unmarshal to.
@@ -127,7 +226,7 @@ func (s *Service) obtainUnblindedBlock(ctx context.Context,
 	var consensusVersion string
 
 	if !exists || len(consensusVersions) == 0 {
-		s.log.Error().Msgf("No %s header", EthConsensusVersion)
+		log.Error().Msgf("No %s header", EthConsensusVersion)
 
 		return nil, fmt.Errorf("no %s header provided", EthConsensusVersion)
 	}
@@ -139,6 +238,11 @@ func (s *Service) obtainUnblindedBlock(ctx context.Context,
 		return nil, err
 	}
 
+	slot, err := signedBlindedBeaconBlock.Slot()
+	if err == nil {
+		trace.SpanFromContext(ctx).SetAttributes(attribute.Int64("slot", int64(slot)))
+	}
+
 	return signedBlindedBeaconBlock, nil
 }
 
@@ -173,9 +277,9 @@ func (s *Service) unmarshalBlindedBlock(ctx context.Context,
 	}
 
 	switch strings.ToLower(contentType) {
-	case "application/octet-stream":
+	case contentTypeSSZ:
 		return s.unmarshalBlindedBlockSSZ(ctx, signedBlindedBeaconBlock, r.Body)
-	case "application/json":
+	case contentTypeJSON:
 		return s.unmarshalBlindedBlockJSON(ctx, signedBlindedBeaconBlock, r.Body)
 	default:
 		return nil, fmt.Errorf("unsupported content type %s", contentType)
@@ -307,3 +411,35 @@ func (s *Service) outputUnblindedBlock(_ context.Context,
 
 	return resp, nil
 }
+
+// marshalUnblindedBlockSSZ marshals the response as the SSZ execution payload and blobs bundle
+// defined by the builder specification.
+func (s *Service) marshalUnblindedBlockSSZ(_ context.Context,
+	resp *unblindBlockResponse,
+) (
+	[]byte,
+	error,
+) {
+	switch resp.Version {
+	case spec.DataVersionDeneb, spec.DataVersionElectra:
+		return (&builderapideneb.ExecutionPayloadAndBlobsBundle{
+			ExecutionPayload: resp.Data.ExecutionPayload,
+			BlobsBundle: &builderapideneb.BlobsBundle{
+				Commitments: resp.Data.BlobsBundle.Commitments,
+				Proofs:      resp.Data.BlobsBundle.Proofs,
+				Blobs:       resp.Data.BlobsBundle.Blobs,
+			},
+		}).MarshalSSZ()
+	case spec.DataVersionFulu:
+		return (&builderapifulu.ExecutionPayloadAndBlobsBundle{
+			ExecutionPayload: resp.Data.ExecutionPayload,
+			BlobsBundle: &builderapifulu.BlobsBundle{
+				Commitments: resp.Data.BlobsBundle.Commitments,
+				Proofs:      resp.Data.BlobsBundle.Proofs,
+				Blobs:       resp.Data.BlobsBundle.Blobs,
+			},
+		}).MarshalSSZ()
+	default:
+		return nil, fmt.Errorf("unsupported version %v", resp.Version)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	mockblockunblinder "github.com/attestantio/go-block-relay/services/blockunblinder/mock"
+	builderspec "github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/go-eth2-client/api"
+	"github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// proposalUnblinder is a block unblinder that returns a fixed proposal.
+type proposalUnblinder struct {
+	*mockblockunblinder.Service
+	proposal *api.VersionedSignedProposal
+}
+
+// UnblindBlock unblinds the given block.
+func (u *proposalUnblinder) UnblindBlock(_ context.Context,
+	_ *api.VersionedSignedBlindedBeaconBlock,
+) (
+	*api.VersionedSignedProposal,
+	error,
+) {
+	return u.proposal, nil
+}
+
+// deliveryRecorder is a bid trace recorder that records delivered proposals.
+type deliveryRecorder struct {
+	delivered []*api.VersionedSignedProposal
+}
+
+// RecordServedBid records a bid served to a proposer.
+func (r *deliveryRecorder) RecordServedBid(_ context.Context,
+	_ phase0.Slot,
+	_ phase0.BLSPubKey,
+	_ phase0.BLSPubKey,
+	_ *builderspec.VersionedSignedBuilderBid,
+) error {
+	return nil
+}
+
+// RecordDeliveredPayload records the delivery of the payload for a previously served bid.
+func (r *deliveryRecorder) RecordDeliveredPayload(_ context.Context,
+	proposal *api.VersionedSignedProposal,
+) error {
+	r.delivered = append(r.delivered, proposal)
+
+	return nil
+}
+
+func TestUnblindBlockRecordsDeliveredPayload(t *testing.T) {
+	tests := []struct {
+		name       string
+		proposal   *api.VersionedSignedProposal
+		statusCode int
+		delivered  int
+	}{
+		{
+			name: "Good",
+			proposal: func() *api.VersionedSignedProposal {
+				proposal := testTraceProposal(0x10)
+				proposal.Electra.SignedBlock.Message.Body.ExecutionPayload.BaseFeePerGas = uint256.NewInt(0)
+
+				return proposal
+			}(),
+			statusCode: http.StatusOK,
+			delivered:  1,
+		},
+		{
+			name: "OutputFails",
+			proposal: &api.VersionedSignedProposal{
+				Version: spec.DataVersionCapella,
+			},
+			statusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			recorder := &deliveryRecorder{}
+			s := &Service{
+				log:                     zerolog.Nop(),
+				maxBlindedBlockBodySize: 1024 * 1024,
+				blockUnblinder: &proposalUnblinder{
+					Service:  mockblockunblinder.New(),
+					proposal: test.proposal,
+				},
+				bidTraceRecorder: recorder,
+			}
+
+			request := &http.Request{
+				Header: map[string][]string{
+					"Content-Type":      {"application/octet-stream"},
+					EthConsensusVersion: {"electra"},
+				},
+				Body: io.NopCloser(bytes.NewReader(testBlindedBlock(t))),
+			}
+			writer := httptest.NewRecorder()
+			s.postUnblindBlock(writer, request)
+			require.Equal(t, test.statusCode, writer.Result().StatusCode)
+			require.Len(t, recorder.delivered, test.delivered)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"net/http"
+
+	relay "github.com/attestantio/go-block-relay"
+	"github.com/attestantio/go-block-relay/loggers"
+	"github.com/attestantio/go-block-relay/services/blockunblinder"
+	"github.com/pkg/errors"
+)
+
+// postUnblindBlockV2 handles the v2 blinded block endpoint, where the relay
+// publishes the unblinded block itself rather than returning it to the caller.
+func (s *Service) postUnblindBlockV2(w http.ResponseWriter, r *http.Request) {
+	log := loggers.WithRequestID(r.Context(), s.log)
+	log.Trace().Msg("unblindBlockV2 called")
+
+	ctx := r.Context()
+
+	publisher, isPublisher := s.blockUnblinder.(blockunblinder.BlockPublisher)
+	if !isPublisher {
+		// Returning not found allows the caller to fall back to the v1 endpoint.
+		log.Debug().Msg("Block unblinder does not support publishing")
+		s.sendResponse(r.Context(), w,
+			http.StatusNotFound,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusNotFound,
+				Message: "Request not supported by service",
+			})
+		s.monitorRequestHandled("unblind block v2", "failure")
+
+		return
+	}
+
+	err := s.decodeRequestBody(w, r, s.maxBlindedBlockBodySize)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to decode request body")
+		statusCode := requestBodyErrorStatus(err)
+		s.sendResponse(r.Context(), w,
+			statusCode,
+			map[string]string{},
+			&APIResponse{
+				Code:    statusCode,
+				Message: err.Error(),
+			})
+		s.monitorRequestHandled("unblind block v2", "failure")
+
+		return
+	}
+
+	signedBlindedBeaconBlock, err := s.obtainUnblindedBlock(ctx, r)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			log.Debug().Err(err).Msg("Blinded block too large")
+			s.sendResponse(r.Context(), w,
+				http.StatusRequestEntityTooLarge,
+				map[string]string{},
+				&APIResponse{
+					Code:    http.StatusRequestEntityTooLarge,
+					Message: maxBytesErr.Error(),
+				})
+			s.monitorRequestHandled("unblind block v2", "failure")
+
+			return
+		}
+
+		log.Error().Err(err).Msg("Unable to obtain unblinded block")
+		s.sendResponse(r.Context(), w,
+			http.StatusBadRequest,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusBadRequest,
+				Message: "Unable to obtain blinded block",
+			})
+		s.monitorRequestHandled("unblind block v2", "failure")
+
+		return
+	}
+
+	signedProposal, err := publisher.UnblindAndPublishBlock(ctx, signedBlindedBeaconBlock)
+	if err != nil {
+		code := http.StatusInternalServerError
+		if errors.Is(err, relay.ErrInvalidOptions) {
+			code = http.StatusBadRequest
+		}
+
+		log.Error().Err(err).Msg("Failed to unblind and publish block")
+		s.sendResponse(r.Context(), w,
+			code,
+			map[string]string{},
+			&APIResponse{
+				Code:    code,
+				Message: "Failed to unblind block",
+			})
+		s.monitorRequestHandled("unblind block v2", "failure")
+
+		return
+	}
+
+	s.recordDeliveredPayload(ctx, signedProposal)
+
+	s.monitorRequestHandled("unblind block v2", "success")
+
+	s.sendResponse(r.Context(), w,
+		http.StatusAccepted,
+		map[string]string{},
+		nil,
+	)
+}
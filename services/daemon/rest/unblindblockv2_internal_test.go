@@ -0,0 +1,264 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/attestantio/go-block-relay/services/bidtracerecorder"
+	standardbidtracerecorder "github.com/attestantio/go-block-relay/services/bidtracerecorder/standard"
+	mockauctioneer "github.com/attestantio/go-block-relay/services/blockauctioneer/mock"
+	"github.com/attestantio/go-block-relay/services/blockunblinder"
+	mockblockunblinder "github.com/attestantio/go-block-relay/services/blockunblinder/mock"
+	mockbuilderbidprovider "github.com/attestantio/go-block-relay/services/builderbidprovider/mock"
+	nullmetrics "github.com/attestantio/go-block-relay/services/metrics/null"
+	mockvalidatorregistrar "github.com/attestantio/go-block-relay/services/validatorregistrar/mock"
+	"github.com/attestantio/go-eth2-client/api"
+	apiv1electra "github.com/attestantio/go-eth2-client/api/v1/electra"
+	"github.com/attestantio/go-eth2-client/spec/altair"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	"github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	bitfield "github.com/prysmaticlabs/go-bitfield"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// proposalPublisher is a block publisher that returns a fixed proposal.
+type proposalPublisher struct {
+	*mockblockunblinder.Service
+	proposal *api.VersionedSignedProposal
+}
+
+// UnblindAndPublishBlock unblinds the given block and publishes it.
+func (p *proposalPublisher) UnblindAndPublishBlock(_ context.Context,
+	_ *api.VersionedSignedBlindedBeaconBlock,
+) (
+	*api.VersionedSignedProposal,
+	error,
+) {
+	return p.proposal, nil
+}
+
+func testBlindedBlock(t *testing.T) []byte {
+	t.Helper()
+
+	blindedBlock, err := (&apiv1electra.SignedBlindedBeaconBlock{
+		Message: &apiv1electra.BlindedBeaconBlock{
+			Body: &apiv1electra.BlindedBeaconBlockBody{
+				ETH1Data: &phase0.ETH1Data{
+					BlockHash: make([]byte, 32),
+				},
+				SyncAggregate: &altair.SyncAggregate{
+					SyncCommitteeBits: bitfield.NewBitvector512(),
+				},
+				ExecutionPayloadHeader: &deneb.ExecutionPayloadHeader{
+					BaseFeePerGas: uint256.NewInt(0),
+				},
+				ExecutionRequests: &electra.ExecutionRequests{},
+			},
+		},
+	}).MarshalSSZ()
+	require.NoError(t, err)
+
+	return blindedBlock
+}
+
+func TestUnblindBlockV2(t *testing.T) {
+	ctx := context.Background()
+
+	blindedBlock := testBlindedBlock(t)
+
+	newService := func(unblinder blockunblinder.Service, listenAddress string) *Service {
+		service, err := New(ctx,
+			WithLogLevel(zerolog.Disabled),
+			WithMonitor(nullmetrics.New()),
+			WithListenAddress(listenAddress),
+			WithValidatorRegistrar(mockvalidatorregistrar.New()),
+			WithBlockAuctioneer(mockauctioneer.New()),
+			WithBlockUnblinder(unblinder),
+			WithBuilderBidProvider(mockbuilderbidprovider.New()),
+		)
+		require.NoError(t, err)
+
+		return service
+	}
+
+	service := newService(mockblockunblinder.New(), ":14736")
+	erroringService := newService(mockblockunblinder.NewErroring(), ":14737")
+	unblinderService := newService(mockblockunblinder.NewUnblinder(), ":14738")
+
+	tests := []struct {
+		name       string
+		service    *Service
+		headers    map[string][]string
+		body       []byte
+		statusCode int
+	}{
+		{
+			name:    "NotSupported",
+			service: unblinderService,
+			headers: map[string][]string{
+				"Content-Type":      {"application/octet-stream"},
+				EthConsensusVersion: {"electra"},
+			},
+			body:       blindedBlock,
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:    "ConsensusVersionMissing",
+			service: service,
+			headers: map[string][]string{
+				"Content-Type": {"application/octet-stream"},
+			},
+			body:       blindedBlock,
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:    "BodyInvalid",
+			service: service,
+			headers: map[string][]string{
+				"Content-Type":      {"application/octet-stream"},
+				EthConsensusVersion: {"electra"},
+			},
+			body:       []byte{0x01, 0x02},
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:    "Erroring",
+			service: erroringService,
+			headers: map[string][]string{
+				"Content-Type":      {"application/octet-stream"},
+				EthConsensusVersion: {"electra"},
+			},
+			body:       blindedBlock,
+			statusCode: http.StatusInternalServerError,
+		},
+		{
+			name:    "Good",
+			service: service,
+			headers: map[string][]string{
+				"Content-Type":      {"application/octet-stream"},
+				EthConsensusVersion: {"electra"},
+			},
+			body:       blindedBlock,
+			statusCode: http.StatusAccepted,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			request := &http.Request{
+				Header: test.headers,
+				Body:   io.NopCloser(bytes.NewReader(test.body)),
+			}
+			writer := httptest.NewRecorder()
+			test.service.postUnblindBlockV2(writer, request)
+			require.Equal(t, test.statusCode, writer.Result().StatusCode)
+		})
+	}
+}
+
+func TestUnblindBlockV2Route(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		unblinder     blockunblinder.Service
+		listenAddress string
+		statusCode    int
+	}{
+		{
+			name:          "Publisher",
+			unblinder:     mockblockunblinder.New(),
+			listenAddress: ":14742",
+			statusCode:    http.StatusBadRequest,
+		},
+		{
+			name:          "NotPublisher",
+			unblinder:     mockblockunblinder.NewUnblinder(),
+			listenAddress: ":14743",
+			statusCode:    http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service, err := New(ctx,
+				WithLogLevel(zerolog.Disabled),
+				WithMonitor(nullmetrics.New()),
+				WithListenAddress(test.listenAddress),
+				WithValidatorRegistrar(mockvalidatorregistrar.New()),
+				WithBlockAuctioneer(mockauctioneer.New()),
+				WithBlockUnblinder(test.unblinder),
+				WithBuilderBidProvider(mockbuilderbidprovider.New()),
+			)
+			require.NoError(t, err)
+
+			// The request has no consensus version, so is rejected if the route exists.
+			request := httptest.NewRequest(http.MethodPost, "/eth/v2/builder/blinded_blocks", bytes.NewReader([]byte{}))
+			writer := httptest.NewRecorder()
+			service.srv.Handler.ServeHTTP(writer, request)
+			require.Equal(t, test.statusCode, writer.Code)
+		})
+	}
+}
+
+func TestUnblindBlockV2RecordsDeliveredPayload(t *testing.T) {
+	ctx := context.Background()
+
+	recorder, err := standardbidtracerecorder.New(ctx,
+		standardbidtracerecorder.WithLogLevel(zerolog.Disabled),
+	)
+	require.NoError(t, err)
+
+	service, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithMonitor(nullmetrics.New()),
+		WithListenAddress(":14740"),
+		WithValidatorRegistrar(mockvalidatorregistrar.New()),
+		WithBlockAuctioneer(mockauctioneer.New()),
+		WithBlockUnblinder(&proposalPublisher{
+			Service:  mockblockunblinder.New(),
+			proposal: testTraceProposal(0x10),
+		}),
+		WithBuilderBidProvider(mockbuilderbidprovider.New()),
+		WithBidTraceRecorder(recorder),
+	)
+	require.NoError(t, err)
+
+	service.recordServedBid(ctx, 1, phase0.BLSPubKey{0x01}, testTraceBid(0x10, 1000))
+
+	request := &http.Request{
+		Header: map[string][]string{
+			"Content-Type":      {"application/octet-stream"},
+			EthConsensusVersion: {"electra"},
+		},
+		Body: io.NopCloser(bytes.NewReader(testBlindedBlock(t))),
+	}
+	writer := httptest.NewRecorder()
+	service.postUnblindBlockV2(writer, request)
+	require.Equal(t, http.StatusAccepted, writer.Result().StatusCode)
+
+	traces, err := recorder.DeliveredPayloads(ctx, &bidtracerecorder.DeliveredPayloadsFilter{})
+	require.NoError(t, err)
+	require.Len(t, traces, 1)
+	require.Equal(t, phase0.Hash32{0x10}, traces[0].BlockHash)
+}
@@ -0,0 +1,107 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/attestantio/go-block-relay/loggers"
+	"github.com/attestantio/go-block-relay/services/validatorregistrar"
+)
+
+func (s *Service) getValidatorRegistration(w http.ResponseWriter, r *http.Request) {
+	log := loggers.WithRequestID(r.Context(), s.log)
+	log.Trace().Msg("getValidatorRegistration called")
+
+	provider, isProvider := s.validatorRegistrar.(validatorregistrar.ValidatorRegistrationProvider)
+	if !isProvider {
+		log.Debug().Msg("Validator registrar does not provide registrations")
+		s.sendResponse(r.Context(), w,
+			http.StatusNotFound,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusNotFound,
+				Message: "Request not supported by service",
+			})
+		s.monitorRequestHandled("validator registration", "failure")
+
+		return
+	}
+
+	query := r.URL.Query()
+	if !query.Has("pubkey") {
+		log.Debug().Msg("No public key supplied")
+		s.sendResponse(r.Context(), w,
+			http.StatusBadRequest,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusBadRequest,
+				Message: "no pubkey specified",
+			})
+		s.monitorRequestHandled("validator registration", "failure")
+
+		return
+	}
+
+	pubkey, err := parseQueryPubkey(query, "pubkey")
+	if err != nil {
+		log.Debug().Err(err).Msg("Invalid public key")
+		s.sendResponse(r.Context(), w,
+			http.StatusBadRequest,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			})
+		s.monitorRequestHandled("validator registration", "failure")
+
+		return
+	}
+
+	registration, err := provider.ValidatorRegistration(r.Context(), *pubkey)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to obtain validator registration")
+		s.sendResponse(r.Context(), w,
+			http.StatusInternalServerError,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusInternalServerError,
+				Message: "Failed to obtain validator registration",
+			})
+		s.monitorRequestHandled("validator registration", "failure")
+
+		return
+	}
+
+	if registration == nil {
+		s.sendResponse(r.Context(), w,
+			http.StatusNotFound,
+			map[string]string{},
+			&APIResponse{
+				Code:    http.StatusNotFound,
+				Message: fmt.Sprintf("no registration found for validator %#x", *pubkey),
+			})
+		s.monitorRequestHandled("validator registration", "success")
+
+		return
+	}
+
+	s.monitorRequestHandled("validator registration", "success")
+	s.sendResponse(r.Context(), w,
+		http.StatusOK,
+		map[string]string{},
+		registration,
+	)
+}
@@ -0,0 +1,135 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-block-relay/services/validatorregistrar"
+	mockvalidatorregistrar "github.com/attestantio/go-block-relay/services/validatorregistrar/mock"
+	"github.com/attestantio/go-block-relay/types"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+)
+
+// registrationProvider provides registrations from a fixed set.
+type registrationProvider struct {
+	registrations map[phase0.BLSPubKey]*types.SignedValidatorRegistration
+	err           error
+}
+
+func (p *registrationProvider) ValidatorRegistration(_ context.Context,
+	pubkey phase0.BLSPubKey,
+) (
+	*types.SignedValidatorRegistration,
+	error,
+) {
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	return p.registrations[pubkey], nil
+}
+
+func (p *registrationProvider) AllValidatorRegistrations(_ context.Context) ([]*types.SignedValidatorRegistration, error) {
+	return nil, p.err
+}
+
+func TestGetValidatorRegistration(t *testing.T) {
+	registered := phase0.BLSPubKey{0x01}
+	provider := &registrationProvider{
+		registrations: map[phase0.BLSPubKey]*types.SignedValidatorRegistration{
+			registered: {
+				Message: &types.ValidatorRegistration{
+					FeeRecipient: bellatrix.ExecutionAddress{0x02},
+					GasLimit:     30000000,
+					Timestamp:    time.Unix(1700000000, 0),
+					Pubkey:       registered,
+				},
+				Signature: phase0.BLSSignature{0x03},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		registrar  validatorregistrar.Service
+		query      string
+		statusCode int
+		body       string
+	}{
+		{
+			name:       "Unsupported",
+			registrar:  mockvalidatorregistrar.New(),
+			query:      "?pubkey=0x010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:       "PubkeyMissing",
+			registrar:  provider,
+			statusCode: http.StatusBadRequest,
+			body:       `{"code":400,"message":"no pubkey specified"}`,
+		},
+		{
+			name:       "PubkeyInvalid",
+			registrar:  provider,
+			query:      "?pubkey=0x01",
+			statusCode: http.StatusBadRequest,
+			body:       `{"code":400,"message":"invalid pubkey 0x01"}`,
+		},
+		{
+			name:       "Erroring",
+			registrar:  &registrationProvider{err: errors.New("error")},
+			query:      "?pubkey=0x010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+			statusCode: http.StatusInternalServerError,
+		},
+		{
+			name:       "Unregistered",
+			registrar:  provider,
+			query:      "?pubkey=0x020000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+			statusCode: http.StatusNotFound,
+			body:       `{"code":404,"message":"no registration found for validator 0x020000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}`,
+		},
+		{
+			name:       "Good",
+			registrar:  provider,
+			query:      "?pubkey=0x010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+			statusCode: http.StatusOK,
+			body:       `{"message":{"fee_recipient":"0x0200000000000000000000000000000000000000","gas_limit":"30000000","timestamp":"1700000000","pubkey":"0x010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"},"signature":"0x030000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				log:                zerolog.Nop(),
+				validatorRegistrar: test.registrar,
+			}
+
+			writer := httptest.NewRecorder()
+			s.getValidatorRegistration(writer, httptest.NewRequest(http.MethodGet, "/relay/v1/data/validator_registration"+test.query, nil))
+			require.Equal(t, test.statusCode, writer.Code)
+			if test.body != "" {
+				require.JSONEq(t, test.body, writer.Body.String())
+			}
+		})
+	}
+}
@@ -1,4 +1,4 @@
-// Copyright © 2022 - 2025 Attestant Limited.
+// Copyright © 2022 - 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -17,21 +17,42 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
 	relay "github.com/attestantio/go-block-relay"
+	"github.com/attestantio/go-block-relay/loggers"
 	"github.com/attestantio/go-block-relay/services/validatorregistrar"
 	"github.com/attestantio/go-block-relay/types"
 	"github.com/pkg/errors"
 )
 
+// errTooManyRegistrations is returned when a request contains more registrations than allowed.
+var errTooManyRegistrations = errors.New("too many registrations")
+
 func (s *Service) postValidatorRegistrations(w http.ResponseWriter, r *http.Request) {
+	log := loggers.WithRequestID(r.Context(), s.log)
+
 	var statusCode int
 
 	var registrationErrors []string
 
-	var err error
+	err := s.decodeRequestBody(w, r, s.maxRegistrationsBodySize)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to decode request body")
+		statusCode = requestBodyErrorStatus(err)
+		s.sendResponse(r.Context(), w,
+			statusCode,
+			map[string]string{},
+			&APIResponse{
+				Code:    statusCode,
+				Message: err.Error(),
+			})
+		s.monitorRequestHandled("validator registrations", "failure")
+
+		return
+	}
 
 	passthroughProvider, isPassthroughProvider := s.validatorRegistrar.(validatorregistrar.ValidatorRegistrationPassthrough)
 	handler, isHandler := s.validatorRegistrar.(validatorregistrar.ValidatorRegistrationHandler)
@@ -42,35 +63,35 @@ func (s *Service) postValidatorRegistrations(w http.ResponseWriter, r *http.Requ
 	case isHandler:
 		statusCode, registrationErrors, err = s.postValidatorRegistrationsHandler(r.Context(), r, handler)
 	default:
-		s.log.Error().Msg("Request not supported by service")
+		log.Error().Msg("Request not supported by service")
 
 		statusCode = http.StatusInternalServerError
 		err = errors.New("Request not supported by service")
 	}
 
 	if err != nil {
-		s.sendResponse(w,
+		s.sendResponse(r.Context(), w,
 			statusCode,
 			map[string]string{},
 			&APIResponse{
 				Code:    statusCode,
 				Message: err.Error(),
 			})
-		monitorRequestHandled("validator registrations", "failure")
+		s.monitorRequestHandled("validator registrations", "failure")
 
 		return
 	}
 
-	monitorRequestHandled("validator registrations", "success")
+	s.monitorRequestHandled("validator registrations", "success")
 
 	if len(registrationErrors) == 0 {
-		s.sendResponse(w,
+		s.sendResponse(r.Context(), w,
 			http.StatusOK,
 			map[string]string{},
 			nil,
 		)
 	} else {
-		s.sendResponse(w,
+		s.sendResponse(r.Context(), w,
 			http.StatusBadRequest,
 			map[string]string{},
 			&APIResponse{
@@ -88,9 +109,16 @@ func (s *Service) postValidatorRegistrationsPassthrough(ctx context.Context,
 	[]string,
 	error,
 ) {
+	log := loggers.WithRequestID(ctx, s.log)
+
 	registrationErrors, err := provider.ValidatorRegistrationsPassthrough(ctx, r.Body)
 	if err != nil {
-		s.log.Error().Err(err).Msg("Failed to register validators with passthrough")
+		log.Error().Err(err).Msg("Failed to register validators with passthrough")
+
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return http.StatusRequestEntityTooLarge, nil, maxBytesErr
+		}
 
 		code := http.StatusInternalServerError
 		if errors.Is(err, relay.ErrInvalidOptions) {
@@ -117,45 +145,237 @@ func (s *Service) postValidatorRegistrationsHandler(ctx context.Context,
 
 	contentType := s.obtainContentType(ctx, r)
 	switch contentType {
-	case "application/json":
+	case contentTypeJSON:
+		if s.registrationsChunkSize > 0 {
+			return s.postValidatorRegistrationsHandlerStream(ctx, r, provider)
+		}
 		registrations, err = s.postValidatorRegistrationsHandlerJSON(ctx, r)
+	case contentTypeSSZ:
+		registrations, err = s.postValidatorRegistrationsHandlerSSZ(ctx, r)
 	default:
 		return http.StatusUnsupportedMediaType, nil, fmt.Errorf("content type %s not supported", contentType)
 	}
 
 	if err != nil {
-		return http.StatusBadRequest, nil, err
+		return requestBodyErrorStatus(err), nil, err
 	}
 
-	registrationErrors, err := provider.ValidatorRegistrations(ctx, registrations)
+	return s.registerValidators(ctx, provider, registrations)
+}
+
+// postValidatorRegistrationsHandlerStream passes JSON registrations to the handler in chunks
+// as they are decoded, so that the full request is never held in memory.  Chunks passed to
+// the handler before an error is encountered remain registered.
+func (s *Service) postValidatorRegistrationsHandlerStream(ctx context.Context,
+	r *http.Request,
+	provider validatorregistrar.ValidatorRegistrationHandler,
+) (
+	int,
+	[]string,
+	error,
+) {
+	registrationErrors := make([]string, 0)
+
+	handlerCode := 0
+	err := s.decodeRegistrationsJSON(ctx, r.Body, s.registrationsChunkSize,
+		func(registrations []*types.SignedValidatorRegistration) error {
+			code, chunkErrors, err := s.registerValidators(ctx, provider, registrations)
+			if err != nil {
+				handlerCode = code
+
+				return err
+			}
+
+			registrationErrors = append(registrationErrors, chunkErrors...)
+
+			return nil
+		},
+	)
 	if err != nil {
-		s.log.Error().Err(err).Msg("Failed to register validators")
+		if handlerCode != 0 {
+			return handlerCode, nil, err
+		}
 
-		code := http.StatusInternalServerError
-		if errors.Is(err, relay.ErrInvalidOptions) {
-			code = http.StatusBadRequest
+		return requestBodyErrorStatus(err), nil, err
+	}
+
+	return http.StatusOK, registrationErrors, nil
+}
+
+// registerValidators passes registrations to the handler, in chunks if so configured,
+// and merges the rejections from each chunk.
+func (s *Service) registerValidators(ctx context.Context,
+	provider validatorregistrar.ValidatorRegistrationHandler,
+	registrations []*types.SignedValidatorRegistration,
+) (
+	int,
+	[]string,
+	error,
+) {
+	log := loggers.WithRequestID(ctx, s.log)
+
+	chunkSize := s.registrationsChunkSize
+	if chunkSize == 0 {
+		chunkSize = max(len(registrations), 1)
+	}
+
+	registrationErrors := make([]string, 0)
+	for start := 0; start < len(registrations); start += chunkSize {
+		chunk := registrations[start:min(start+chunkSize, len(registrations))]
+
+		chunkErrors, err := provider.ValidatorRegistrations(ctx, chunk)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to register validators")
+
+			code := http.StatusInternalServerError
+			if errors.Is(err, relay.ErrInvalidOptions) {
+				code = http.StatusBadRequest
+			}
+
+			return code, nil, errors.Wrap(err, "failed to register validators")
 		}
 
-		return code, nil, errors.Wrap(err, "failed to register validators")
+		registrationErrors = append(registrationErrors, chunkErrors...)
 	}
 
 	return http.StatusOK, registrationErrors, nil
 }
 
-func (s *Service) postValidatorRegistrationsHandlerJSON(_ context.Context,
+func (s *Service) postValidatorRegistrationsHandlerJSON(ctx context.Context,
 	r *http.Request,
 ) (
 	[]*types.SignedValidatorRegistration,
 	error,
 ) {
-	// We need to unmarshal the request body ourselves.
 	registrations := make([]*types.SignedValidatorRegistration, 0)
 
-	err := json.NewDecoder(r.Body).Decode(&registrations)
+	// Decoding as a single chunk hands over the decoded slice without copying it.
+	err := s.decodeRegistrationsJSON(ctx, r.Body, 0,
+		func(chunk []*types.SignedValidatorRegistration) error {
+			registrations = chunk
+
+			return nil
+		},
+	)
 	if err != nil {
-		s.log.Debug().Err(err).Msg("Supplied with invalid data")
+		return nil, err
+	}
+
+	return registrations, nil
+}
+
+// decodeRegistrationsJSON decodes a JSON array of registrations, passing them to the supplied
+// function in chunks of up to the given size, or as a single chunk if the size is 0.  The array
+// is decoded an element at a time, so that an oversized request is rejected without decoding all
+// of it.
+func (s *Service) decodeRegistrationsJSON(ctx context.Context,
+	body io.Reader,
+	chunkSize int,
+	handle func([]*types.SignedValidatorRegistration) error,
+) error {
+	log := loggers.WithRequestID(ctx, s.log)
+
+	decoder := json.NewDecoder(body)
+
+	token, err := decoder.Token()
+	if err != nil {
+		log.Debug().Err(err).Msg("Supplied with invalid data")
+
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if delim, isDelim := token.(json.Delim); !isDelim || delim != '[' {
+		log.Debug().Msg("Supplied with invalid data")
+
+		return errors.New("invalid JSON: expected array")
+	}
+
+	total := 0
+	// The chunk grows as registrations arrive, so a small request does not pay for a large chunk size.
+	chunk := make([]*types.SignedValidatorRegistration, 0)
+	for decoder.More() {
+		registration := &types.SignedValidatorRegistration{}
+
+		err = decoder.Decode(registration)
+		if err != nil {
+			log.Debug().Err(err).Msg("Supplied with invalid data")
+
+			return errors.Wrap(err, "invalid JSON")
+		}
+
+		total++
+		if total > s.maxRegistrations {
+			log.Debug().Int("max_registrations", s.maxRegistrations).Msg("Supplied with too many registrations")
+
+			return fmt.Errorf("%w; maximum is %d", errTooManyRegistrations, s.maxRegistrations)
+		}
+
+		chunk = append(chunk, registration)
+		if chunkSize > 0 && len(chunk) == chunkSize {
+			err = handle(chunk)
+			if err != nil {
+				return err
+			}
+
+			// The handler may retain the chunk, so start a new one rather than reuse it.
+			chunk = make([]*types.SignedValidatorRegistration, 0, chunkSize)
+		}
+	}
+
+	// Consume the closing bracket.
+	_, err = decoder.Token()
+	if err != nil {
+		log.Debug().Err(err).Msg("Supplied with invalid data")
 
-		return nil, errors.Wrap(err, "invalid JSON")
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	if len(chunk) > 0 {
+		return handle(chunk)
+	}
+
+	return nil
+}
+
+func (s *Service) postValidatorRegistrationsHandlerSSZ(ctx context.Context,
+	r *http.Request,
+) (
+	[]*types.SignedValidatorRegistration,
+	error,
+) {
+	log := loggers.WithRequestID(ctx, s.log)
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed to read request body")
+
+		return nil, errors.Wrap(err, "failed to read request body")
+	}
+
+	// The body is an SSZ list of fixed-size signed registrations.
+	registrationSize := (&types.SignedValidatorRegistration{}).SizeSSZ()
+	if len(data)%registrationSize != 0 {
+		log.Debug().Int("size", len(data)).Msg("Supplied with invalid data")
+
+		return nil, fmt.Errorf("invalid SSZ: length %d not a multiple of %d", len(data), registrationSize)
+	}
+
+	if len(data)/registrationSize > s.maxRegistrations {
+		log.Debug().Int("max_registrations", s.maxRegistrations).Msg("Supplied with too many registrations")
+
+		return nil, fmt.Errorf("%w; maximum is %d", errTooManyRegistrations, s.maxRegistrations)
+	}
+
+	registrations := make([]*types.SignedValidatorRegistration, len(data)/registrationSize)
+	for i := range registrations {
+		registrations[i] = &types.SignedValidatorRegistration{}
+
+		err = registrations[i].UnmarshalSSZ(data[i*registrationSize : (i+1)*registrationSize])
+		if err != nil {
+			log.Debug().Err(err).Msg("Supplied with invalid data")
+
+			return nil, errors.Wrap(err, "invalid SSZ")
+		}
 	}
 
 	return registrations, nil
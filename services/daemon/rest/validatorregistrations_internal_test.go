@@ -1,4 +1,4 @@
-// Copyright © 2022, 2025 Attestant Limited.
+// Copyright © 2022 - 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -16,16 +16,25 @@ package rest
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	relay "github.com/attestantio/go-block-relay"
 	mockauctioneer "github.com/attestantio/go-block-relay/services/blockauctioneer/mock"
 	mockblockunblinder "github.com/attestantio/go-block-relay/services/blockunblinder/mock"
 	mockbuilderbidprovider "github.com/attestantio/go-block-relay/services/builderbidprovider/mock"
 	nullmetrics "github.com/attestantio/go-block-relay/services/metrics/null"
 	mockvalidatorregistrar "github.com/attestantio/go-block-relay/services/validatorregistrar/mock"
+	"github.com/attestantio/go-block-relay/types"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 )
@@ -117,6 +126,20 @@ func TestValidatorRegistrationsContentType(t *testing.T) {
 	)
 	require.NoError(t, err)
 
+	limitedService, err := New(ctx,
+		WithLogLevel(zerolog.Disabled),
+		WithMonitor(monitor),
+		WithServerName("server.attestant.io"),
+		WithListenAddress(":14734"),
+		WithMaxRegistrationsBodySize(1024),
+		WithMaxRegistrations(1),
+		WithValidatorRegistrar(registrar),
+		WithBlockAuctioneer(auctioneer),
+		WithBlockUnblinder(unblinder),
+		WithBuilderBidProvider(builderBidProvider),
+	)
+	require.NoError(t, err)
+
 	tests := []struct {
 		name       string
 		service    *Service
@@ -158,6 +181,42 @@ func TestValidatorRegistrationsContentType(t *testing.T) {
 			writer:     httptest.NewRecorder(),
 			statusCode: http.StatusOK,
 		},
+		{
+			name:    "SSZ",
+			service: service,
+			request: &http.Request{
+				Header: map[string][]string{
+					"Content-Type": {"application/octet-stream"},
+				},
+				Body: io.NopCloser(bytes.NewReader(sszRegistrations(t, 2))),
+			},
+			writer:     httptest.NewRecorder(),
+			statusCode: http.StatusOK,
+		},
+		{
+			name:    "SSZEmpty",
+			service: service,
+			request: &http.Request{
+				Header: map[string][]string{
+					"Content-Type": {"application/octet-stream"},
+				},
+				Body: io.NopCloser(bytes.NewReader([]byte{})),
+			},
+			writer:     httptest.NewRecorder(),
+			statusCode: http.StatusOK,
+		},
+		{
+			name:    "SSZTruncated",
+			service: service,
+			request: &http.Request{
+				Header: map[string][]string{
+					"Content-Type": {"application/octet-stream"},
+				},
+				Body: io.NopCloser(bytes.NewReader(sszRegistrations(t, 2)[:300])),
+			},
+			writer:     httptest.NewRecorder(),
+			statusCode: http.StatusBadRequest,
+		},
 		{
 			name:    "Bad",
 			service: service,
@@ -170,6 +229,30 @@ func TestValidatorRegistrationsContentType(t *testing.T) {
 			writer:     httptest.NewRecorder(),
 			statusCode: http.StatusUnsupportedMediaType,
 		},
+		{
+			name:    "SSZTooMany",
+			service: limitedService,
+			request: &http.Request{
+				Header: map[string][]string{
+					"Content-Type": {"application/octet-stream"},
+				},
+				Body: io.NopCloser(bytes.NewReader(sszRegistrations(t, 2))),
+			},
+			writer:     httptest.NewRecorder(),
+			statusCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:    "JSONTooLarge",
+			service: limitedService,
+			request: &http.Request{
+				Header: map[string][]string{
+					"Content-Type": {"application/json"},
+				},
+				Body: io.NopCloser(bytes.NewReader([]byte("[" + strings.Repeat(" ", 2048) + "]"))),
+			},
+			writer:     httptest.NewRecorder(),
+			statusCode: http.StatusRequestEntityTooLarge,
+		},
 	}
 
 	for _, test := range tests {
@@ -179,3 +262,287 @@ func TestValidatorRegistrationsContentType(t *testing.T) {
 		})
 	}
 }
+
+// testRegistrations creates a number of signed validator registrations.
+func testRegistrations(count int) []*types.SignedValidatorRegistration {
+	registrations := make([]*types.SignedValidatorRegistration, count)
+	for i := range registrations {
+		registrations[i] = &types.SignedValidatorRegistration{
+			Message: &types.ValidatorRegistration{
+				FeeRecipient: bellatrix.ExecutionAddress{byte(i)},
+				GasLimit:     30000000,
+				Timestamp:    time.Unix(1700000000+int64(i), 0),
+				Pubkey:       phase0.BLSPubKey{byte(i)},
+			},
+			Signature: phase0.BLSSignature{byte(i)},
+		}
+	}
+
+	return registrations
+}
+
+// sszRegistrations creates the SSZ encoding of a list of signed validator registrations.
+func sszRegistrations(t *testing.T, count int) []byte {
+	t.Helper()
+
+	data := make([]byte, 0)
+	for _, registration := range testRegistrations(count) {
+		var err error
+		data, err = registration.MarshalSSZTo(data)
+		require.NoError(t, err)
+	}
+
+	return data
+}
+
+func TestValidatorRegistrationsHandlerSSZ(t *testing.T) {
+	ctx := context.Background()
+
+	service := &Service{
+		log:              zerolog.Nop(),
+		maxRegistrations: 3,
+	}
+
+	registrations, err := service.postValidatorRegistrationsHandlerSSZ(ctx, &http.Request{
+		Body: io.NopCloser(bytes.NewReader(sszRegistrations(t, 3))),
+	})
+	require.NoError(t, err)
+	require.Len(t, registrations, 3)
+	for i, expected := range testRegistrations(3) {
+		require.Equal(t, expected.Message.Pubkey, registrations[i].Message.Pubkey)
+		require.Equal(t, expected.Message.FeeRecipient, registrations[i].Message.FeeRecipient)
+		require.True(t, expected.Message.Timestamp.Equal(registrations[i].Message.Timestamp))
+		require.Equal(t, expected.Signature, registrations[i].Signature)
+	}
+
+	_, err = service.postValidatorRegistrationsHandlerSSZ(ctx, &http.Request{
+		Body: io.NopCloser(bytes.NewReader(sszRegistrations(t, 3)[:200])),
+	})
+	require.EqualError(t, err, "invalid SSZ: length 200 not a multiple of 180")
+
+	_, err = service.postValidatorRegistrationsHandlerSSZ(ctx, &http.Request{
+		Body: io.NopCloser(bytes.NewReader(sszRegistrations(t, 4))),
+	})
+	require.EqualError(t, err, "too many registrations; maximum is 3")
+}
+
+func TestValidatorRegistrationsHandlerJSON(t *testing.T) {
+	ctx := context.Background()
+
+	service := &Service{
+		log:              zerolog.Nop(),
+		maxRegistrations: 3,
+	}
+
+	jsonRegistrations := func(count int) []byte {
+		data, err := json.Marshal(testRegistrations(count))
+		require.NoError(t, err)
+
+		return data
+	}
+
+	tests := []struct {
+		name  string
+		body  []byte
+		count int
+		err   string
+	}{
+		{
+			name: "Empty",
+			body: []byte{},
+			err:  "invalid JSON: EOF",
+		},
+		{
+			name: "NotArray",
+			body: []byte(`{}`),
+			err:  "invalid JSON: expected array",
+		},
+		{
+			name:  "None",
+			body:  []byte(`[]`),
+			count: 0,
+		},
+		{
+			name:  "Good",
+			body:  jsonRegistrations(3),
+			count: 3,
+		},
+		{
+			name: "TooMany",
+			body: jsonRegistrations(4),
+			err:  "too many registrations; maximum is 3",
+		},
+		{
+			name: "Truncated",
+			body: jsonRegistrations(3)[:100],
+			err:  "invalid JSON: unexpected EOF",
+		},
+		{
+			name: "Unterminated",
+			body: bytes.TrimSuffix(jsonRegistrations(3), []byte("]")),
+			err:  "invalid JSON: unexpected end of JSON input",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			registrations, err := service.postValidatorRegistrationsHandlerJSON(ctx, &http.Request{
+				Body: io.NopCloser(bytes.NewReader(test.body)),
+			})
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, registrations, test.count)
+		})
+	}
+}
+
+// chunkRecorder records the chunks of registrations it is passed, rejecting the first
+// registration of each and failing once it has received the given number of chunks.
+type chunkRecorder struct {
+	chunks  []int
+	failAt  int
+	invalid error
+}
+
+func (c *chunkRecorder) ValidatorRegistrations(_ context.Context,
+	registrations []*types.SignedValidatorRegistration,
+) (
+	[]string,
+	error,
+) {
+	c.chunks = append(c.chunks, len(registrations))
+	if len(c.chunks) == c.failAt {
+		if c.invalid != nil {
+			return nil, c.invalid
+		}
+
+		return nil, errors.New("failed")
+	}
+
+	return []string{fmt.Sprintf("%d: rejected", registrations[0].Message.Pubkey[0])}, nil
+}
+
+func TestValidatorRegistrationsChunked(t *testing.T) {
+	jsonBody, err := json.Marshal(testRegistrations(5))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        []byte
+		chunkSize   int
+		recorder    *chunkRecorder
+		statusCode  int
+		message     string
+		chunks      []int
+	}{
+		{
+			name:        "Unchunked",
+			contentType: contentTypeJSON,
+			body:        jsonBody,
+			recorder:    &chunkRecorder{},
+			statusCode:  http.StatusBadRequest,
+			message:     "0: rejected",
+			chunks:      []int{5},
+		},
+		{
+			name:        "JSON",
+			contentType: contentTypeJSON,
+			body:        jsonBody,
+			chunkSize:   2,
+			recorder:    &chunkRecorder{},
+			statusCode:  http.StatusBadRequest,
+			message:     "0: rejected;2: rejected;4: rejected",
+			chunks:      []int{2, 2, 1},
+		},
+		{
+			name:        "JSONExact",
+			contentType: contentTypeJSON,
+			body:        jsonBody,
+			chunkSize:   5,
+			recorder:    &chunkRecorder{},
+			statusCode:  http.StatusBadRequest,
+			message:     "0: rejected",
+			chunks:      []int{5},
+		},
+		{
+			name:        "JSONEmpty",
+			contentType: contentTypeJSON,
+			body:        []byte("[]"),
+			chunkSize:   2,
+			recorder:    &chunkRecorder{},
+			statusCode:  http.StatusOK,
+		},
+		{
+			name:        "JSONTruncated",
+			contentType: contentTypeJSON,
+			body:        jsonBody[:len(jsonBody)-100],
+			chunkSize:   2,
+			recorder:    &chunkRecorder{},
+			statusCode:  http.StatusBadRequest,
+			message:     "invalid JSON: unexpected EOF",
+			chunks:      []int{2, 2},
+		},
+		{
+			name:        "JSONHandlerFailed",
+			contentType: contentTypeJSON,
+			body:        jsonBody,
+			chunkSize:   2,
+			recorder:    &chunkRecorder{failAt: 2},
+			statusCode:  http.StatusInternalServerError,
+			message:     "failed to register validators: failed",
+			chunks:      []int{2, 2},
+		},
+		{
+			name:        "JSONHandlerInvalid",
+			contentType: contentTypeJSON,
+			body:        jsonBody,
+			chunkSize:   2,
+			recorder:    &chunkRecorder{failAt: 1, invalid: relay.ErrInvalidOptions},
+			statusCode:  http.StatusBadRequest,
+			message:     "failed to register validators: invalid options",
+			chunks:      []int{2},
+		},
+		{
+			name:        "SSZ",
+			contentType: contentTypeSSZ,
+			body:        sszRegistrations(t, 5),
+			chunkSize:   2,
+			recorder:    &chunkRecorder{},
+			statusCode:  http.StatusBadRequest,
+			message:     "0: rejected;2: rejected;4: rejected",
+			chunks:      []int{2, 2, 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Service{
+				log:                      zerolog.Nop(),
+				maxRegistrationsBodySize: 1024 * 1024,
+				maxRegistrations:         10,
+				registrationsChunkSize:   test.chunkSize,
+				validatorRegistrar:       test.recorder,
+			}
+
+			r := httptest.NewRequest(http.MethodPost, "/eth/v1/builder/validators", bytes.NewReader(test.body))
+			r.Header.Set("Content-Type", test.contentType)
+
+			writer := httptest.NewRecorder()
+			s.postValidatorRegistrations(writer, r)
+			require.Equal(t, test.statusCode, writer.Code)
+
+			if test.message != "" {
+				resp := &APIResponse{}
+				require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+				require.Equal(t, test.message, resp.Message)
+			}
+
+			require.Equal(t, test.chunks, test.recorder.chunks)
+		})
+	}
+}
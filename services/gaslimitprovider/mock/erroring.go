@@ -0,0 +1,34 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"errors"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// ErroringService is a mock gas limit provider.
+type ErroringService struct{}
+
+// NewErroring creates a new mock gas limit provider.
+func NewErroring() *ErroringService {
+	return &ErroringService{}
+}
+
+// GasLimit provides the gas limit of the execution block with the given hash.
+func (s *ErroringService) GasLimit(_ context.Context, _ phase0.Hash32) (uint64, error) {
+	return 0, errors.New("error")
+}
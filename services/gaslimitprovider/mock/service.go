@@ -0,0 +1,37 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+)
+
+// Service is a mock gas limit provider that provides a fixed gas limit for all blocks.
+type Service struct {
+	gasLimit uint64
+}
+
+// New creates a new mock gas limit provider.
+func New(gasLimit uint64) *Service {
+	return &Service{
+		gasLimit: gasLimit,
+	}
+}
+
+// GasLimit provides the gas limit of the execution block with the given hash.
+func (s *Service) GasLimit(_ context.Context, _ phase0.Hash32) (uint64, error) {
+	return s.gasLimit, nil
+}
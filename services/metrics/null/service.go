@@ -1,4 +1,4 @@
-// Copyright © 2022 Attestant Limited.
+// Copyright © 2022, 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -13,7 +13,10 @@
 
 package null
 
-import "github.com/attestantio/go-block-relay/services/metrics"
+import (
+	"github.com/attestantio/go-block-relay/services/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
 
 // Service is a metrics service that drops metrics.
 type Service struct{}
@@ -27,3 +30,8 @@ func New() metrics.Service {
 func (s *Service) Presenter() string {
 	return "null"
 }
+
+// Registerer returns nil, as the null service does not collect metrics.
+func (*Service) Registerer() prometheus.Registerer {
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opentelemetry
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel        zerolog.Level
+	endpoint        string
+	serviceName     string
+	metricsInterval time.Duration
+	registry        *prometheus.Registry
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithEndpoint sets the URL of the OTLP HTTP endpoint, for example "http://localhost:4318".
+func WithEndpoint(endpoint string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.endpoint = endpoint
+	})
+}
+
+// WithServiceName sets the service name reported with traces and metrics.
+func WithServiceName(name string) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.serviceName = name
+	})
+}
+
+// WithMetricsInterval sets the interval between exports of metrics.
+func WithMetricsInterval(interval time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.metricsInterval = interval
+	})
+}
+
+// WithRegistry sets the registry in which metrics are registered and from which they are exported.
+// If not supplied a new registry is created for the service.
+func WithRegistry(registry *prometheus.Registry) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.registry = registry
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:        zerolog.GlobalLevel(),
+		serviceName:     "blockrelay",
+		metricsInterval: 15 * time.Second,
+	}
+
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.endpoint == "" {
+		return nil, errors.New("no endpoint specified")
+	}
+
+	if parameters.serviceName == "" {
+		return nil, errors.New("no service name specified")
+	}
+
+	if parameters.metricsInterval <= 0 {
+		return nil, errors.New("metrics interval must be greater than 0")
+	}
+
+	return &parameters, nil
+}
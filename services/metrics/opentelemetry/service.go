@@ -0,0 +1,141 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opentelemetry
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+	prometheusbridge "go.opentelemetry.io/contrib/bridges/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// shutdownTimeout is the time allowed to flush outstanding traces and metrics on shutdown.
+const shutdownTimeout = 5 * time.Second
+
+// Service is a metrics service exporting traces and metrics via OpenTelemetry.
+type Service struct {
+	log            zerolog.Logger
+	registry       *prometheus.Registry
+	tracerProvider *sdktrace.TracerProvider
+	meterProvider  *sdkmetric.MeterProvider
+}
+
+// New creates a new OpenTelemetry metrics service.
+// The service's tracer provider is installed as the global tracer provider, and
+// metrics registered with the service are exported alongside traces.
+func New(ctx context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "metrics").Str("impl", "opentelemetry").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	registry := parameters.registry
+	if registry == nil {
+		registry = prometheus.NewRegistry()
+		registry.MustRegister(
+			collectors.NewGoCollector(),
+			collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		)
+	}
+
+	res, err := resource.Merge(resource.Default(),
+		resource.NewSchemaless(semconv.ServiceName(parameters.serviceName)),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create resource")
+	}
+
+	traceExporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(parameters.endpoint))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create trace exporter")
+	}
+
+	metricExporter, err := otlpmetrichttp.New(ctx, otlpmetrichttp.WithEndpointURL(parameters.endpoint))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create metric exporter")
+	}
+
+	s := &Service{
+		log:      log,
+		registry: registry,
+		tracerProvider: sdktrace.NewTracerProvider(
+			sdktrace.WithBatcher(traceExporter),
+			sdktrace.WithResource(res),
+		),
+		meterProvider: sdkmetric.NewMeterProvider(
+			sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter,
+				sdkmetric.WithInterval(parameters.metricsInterval),
+				sdkmetric.WithProducer(prometheusbridge.NewMetricProducer(prometheusbridge.WithGatherer(registry))),
+			)),
+			sdkmetric.WithResource(res),
+		),
+	}
+
+	otel.SetTracerProvider(s.tracerProvider)
+	otel.SetMeterProvider(s.meterProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	go s.shutdownOnDone(ctx)
+
+	return s, nil
+}
+
+// Presenter returns the presenter for the events.
+func (*Service) Presenter() string {
+	return "opentelemetry"
+}
+
+// Registerer returns the registerer for the service's registry.
+func (s *Service) Registerer() prometheus.Registerer {
+	return s.registry
+}
+
+// shutdownOnDone flushes and shuts down the exporters when the context is done.
+func (s *Service) shutdownOnDone(ctx context.Context) {
+	<-ctx.Done()
+	s.log.Trace().Msg("Context done, shutting down")
+
+	// Parent context is done, so use a fresh one to allow the final export.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	err := s.tracerProvider.Shutdown(shutdownCtx)
+	if err != nil {
+		s.log.Warn().Err(err).Msg("Failed to shut down tracer provider")
+	}
+
+	err = s.meterProvider.Shutdown(shutdownCtx)
+	if err != nil {
+		s.log.Warn().Err(err).Msg("Failed to shut down meter provider")
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package opentelemetry_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-block-relay/services/metrics/opentelemetry"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+)
+
+func TestService(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		params []opentelemetry.Parameter
+		err    string
+	}{
+		{
+			name: "EndpointMissing",
+			params: []opentelemetry.Parameter{
+				opentelemetry.WithLogLevel(zerolog.Disabled),
+			},
+			err: "problem with parameters: no endpoint specified",
+		},
+		{
+			name: "ServiceNameMissing",
+			params: []opentelemetry.Parameter{
+				opentelemetry.WithLogLevel(zerolog.Disabled),
+				opentelemetry.WithEndpoint("http://localhost:4318"),
+				opentelemetry.WithServiceName(""),
+			},
+			err: "problem with parameters: no service name specified",
+		},
+		{
+			name: "MetricsIntervalZero",
+			params: []opentelemetry.Parameter{
+				opentelemetry.WithLogLevel(zerolog.Disabled),
+				opentelemetry.WithEndpoint("http://localhost:4318"),
+				opentelemetry.WithMetricsInterval(0),
+			},
+			err: "problem with parameters: metrics interval must be greater than 0",
+		},
+		{
+			name: "Good",
+			params: []opentelemetry.Parameter{
+				opentelemetry.WithLogLevel(zerolog.Disabled),
+				opentelemetry.WithEndpoint("http://localhost:4318"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := opentelemetry.New(ctx, test.params...)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "opentelemetry", s.Presenter())
+				require.NotNil(t, s.Registerer())
+			}
+		})
+	}
+}
+
+// collector is a minimal OTLP HTTP collector that records the paths it receives.
+type collector struct {
+	mu       sync.Mutex
+	received map[string]int
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	c.received[r.URL.Path]++
+	c.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (c *collector) count(path string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.received[path]
+}
+
+func TestExport(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := &collector{
+		received: make(map[string]int),
+	}
+	server := httptest.NewServer(c)
+	defer server.Close()
+
+	s, err := opentelemetry.New(ctx,
+		opentelemetry.WithLogLevel(zerolog.Disabled),
+		opentelemetry.WithEndpoint(server.URL),
+		opentelemetry.WithMetricsInterval(50*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	counter := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "test_total",
+		Help: "Test",
+	})
+	require.NoError(t, s.Registerer().Register(counter))
+	counter.Inc()
+
+	_, span := otel.Tracer("test").Start(ctx, "Test")
+	span.End()
+
+	require.Eventually(t, func() bool {
+		return c.count("/v1/metrics") > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// Shutting down flushes outstanding spans.
+	cancel()
+	require.Eventually(t, func() bool {
+		return c.count("/v1/traces") > 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -1,4 +1,4 @@
-// Copyright © 2022 Attestant Limited.
+// Copyright © 2022, 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -16,12 +16,14 @@ package prometheus
 import (
 	"errors"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
 )
 
 type parameters struct {
 	logLevel zerolog.Level
 	address  string
+	registry *prometheus.Registry
 }
 
 // Parameter is the interface for service parameters.
@@ -49,6 +51,14 @@ func WithAddress(address string) Parameter {
 	})
 }
 
+// WithRegistry sets the registry in which metrics are registered and from which they are served.
+// If not supplied a new registry is created for the service.
+func WithRegistry(registry *prometheus.Registry) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.registry = registry
+	})
+}
+
 // parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
 func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
 	parameters := parameters{
@@ -1,4 +1,4 @@
-// Copyright © 2022, 2023 Attestant Limited.
+// Copyright © 2022 - 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -19,6 +19,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/zerolog"
 	zerologger "github.com/rs/zerolog/log"
@@ -26,7 +28,8 @@ import (
 
 // Service is a metrics service exposing metrics via prometheus.
 type Service struct {
-	log zerolog.Logger
+	log      zerolog.Logger
+	registry *prometheus.Registry
 }
 
 // New creates a new prometheus metrics service.
@@ -42,15 +45,28 @@ func New(_ context.Context, params ...Parameter) (*Service, error) {
 		log = log.Level(parameters.logLevel)
 	}
 
+	registry := parameters.registry
+	if registry == nil {
+		registry = prometheus.NewRegistry()
+		registry.MustRegister(
+			collectors.NewGoCollector(),
+			collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		)
+	}
+
 	s := &Service{
-		log: log,
+		log:      log,
+		registry: registry,
 	}
 
 	go func() {
-		http.Handle("/metrics", promhttp.Handler())
+		// Use a dedicated mux so that multiple services can run in the same process.
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
 
 		server := &http.Server{
 			Addr:              parameters.address,
+			Handler:           mux,
 			ReadHeaderTimeout: 5 * time.Second,
 		}
 
@@ -67,3 +83,8 @@ func New(_ context.Context, params ...Parameter) (*Service, error) {
 func (s *Service) Presenter() string {
 	return "prometheus"
 }
+
+// Registerer returns the registerer for the service's registry.
+func (s *Service) Registerer() prometheus.Registerer {
+	return s.registry
+}
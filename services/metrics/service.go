@@ -1,4 +1,4 @@
-// Copyright © 2022 Attestant Limited.
+// Copyright © 2022, 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -14,8 +14,14 @@
 // Package metrics provides an interface to present metrics.
 package metrics
 
+import "github.com/prometheus/client_golang/prometheus"
+
 // Service is the generic metrics service.
 type Service interface {
 	// Presenter provides the presenter for this service.
 	Presenter() string
+
+	// Registerer provides the registerer with which services register their metrics.
+	// It returns nil if the service does not collect metrics.
+	Registerer() prometheus.Registerer
 }
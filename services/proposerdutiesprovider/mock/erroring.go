@@ -0,0 +1,34 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+	"errors"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// ErroringService is a mock proposer duties provider.
+type ErroringService struct{}
+
+// NewErroring creates a new mock proposer duties provider.
+func NewErroring() *ErroringService {
+	return &ErroringService{}
+}
+
+// ProposerDuties provides the proposer duties for the current and next epoch.
+func (s *ErroringService) ProposerDuties(_ context.Context) ([]*apiv1.ProposerDuty, error) {
+	return nil, errors.New("error")
+}
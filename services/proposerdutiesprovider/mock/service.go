@@ -0,0 +1,37 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mock
+
+import (
+	"context"
+
+	apiv1 "github.com/attestantio/go-eth2-client/api/v1"
+)
+
+// Service is a mock proposer duties provider that provides a fixed set of duties.
+type Service struct {
+	duties []*apiv1.ProposerDuty
+}
+
+// New creates a new mock proposer duties provider.
+func New(duties ...*apiv1.ProposerDuty) *Service {
+	return &Service{
+		duties: duties,
+	}
+}
+
+// ProposerDuties provides the proposer duties for the current and next epoch.
+func (s *Service) ProposerDuties(_ context.Context) ([]*apiv1.ProposerDuty, error) {
+	return s.duties, nil
+}
@@ -47,3 +47,8 @@ func (s *Service) ValidatorRegistrationsPassthrough(_ context.Context,
 ) {
 	return nil, nil
 }
+
+// Healthy returns true if the service is able to handle requests.
+func (s *Service) Healthy(_ context.Context) bool {
+	return true
+}
@@ -1,4 +1,4 @@
-// Copyright © 2022 Attestant Limited.
+// Copyright © 2022, 2026 Attestant Limited.
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
@@ -18,6 +18,7 @@ import (
 	"io"
 
 	"github.com/attestantio/go-block-relay/types"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
 )
 
 // Service defines the validator registrar service.
@@ -34,3 +35,19 @@ type ValidatorRegistrationHandler interface {
 	// ValidatorRegistrations handles validator registrations.
 	ValidatorRegistrations(ctx context.Context, registrations []*types.SignedValidatorRegistration) ([]string, error)
 }
+
+// ValidatorRegistrationProvider is the interface for providing stored validator registrations.
+type ValidatorRegistrationProvider interface {
+	// ValidatorRegistration provides the latest registration for the given public key.
+	// If there is no registration for the public key this returns nil.
+	ValidatorRegistration(ctx context.Context, pubkey phase0.BLSPubKey) (*types.SignedValidatorRegistration, error)
+
+	// AllValidatorRegistrations provides the latest registration for every registered validator.
+	AllValidatorRegistrations(ctx context.Context) ([]*types.SignedValidatorRegistration, error)
+}
+
+// HealthReporter is the interface for validator registrars that can report their health.
+type HealthReporter interface {
+	// Healthy returns true if the service is able to handle requests.
+	Healthy(ctx context.Context) bool
+}
@@ -0,0 +1,93 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"errors"
+	"time"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+)
+
+type parameters struct {
+	logLevel                 zerolog.Level
+	futureTimestampTolerance time.Duration
+	genesisForkVersion       phase0.Version
+	verificationWorkers      int
+}
+
+// Parameter is the interface for service parameters.
+type Parameter interface {
+	apply(p *parameters)
+}
+
+type parameterFunc func(*parameters)
+
+func (f parameterFunc) apply(p *parameters) {
+	f(p)
+}
+
+// WithLogLevel sets the log level for the module.
+func WithLogLevel(logLevel zerolog.Level) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.logLevel = logLevel
+	})
+}
+
+// WithFutureTimestampTolerance sets how far in the future a registration's timestamp can be before it is rejected.
+func WithFutureTimestampTolerance(tolerance time.Duration) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.futureTimestampTolerance = tolerance
+	})
+}
+
+// WithGenesisForkVersion sets the genesis fork version of the chain, used to verify registration signatures.
+func WithGenesisForkVersion(version phase0.Version) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.genesisForkVersion = version
+	})
+}
+
+// WithVerificationWorkers sets the number of registration signatures that can be verified in parallel.
+func WithVerificationWorkers(workers int) Parameter {
+	return parameterFunc(func(p *parameters) {
+		p.verificationWorkers = workers
+	})
+}
+
+// parseAndCheckParameters parses and checks parameters to ensure that mandatory parameters are present and correct.
+func parseAndCheckParameters(params ...Parameter) (*parameters, error) {
+	parameters := parameters{
+		logLevel:                 zerolog.GlobalLevel(),
+		futureTimestampTolerance: 10 * time.Second,
+		verificationWorkers:      1,
+	}
+
+	for _, p := range params {
+		if params != nil {
+			p.apply(&parameters)
+		}
+	}
+
+	if parameters.futureTimestampTolerance < 0 {
+		return nil, errors.New("future timestamp tolerance cannot be negative")
+	}
+
+	if parameters.verificationWorkers <= 0 {
+		return nil, errors.New("verification workers must be greater than 0")
+	}
+
+	return &parameters, nil
+}
@@ -0,0 +1,194 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-block-relay/loggers"
+	"github.com/attestantio/go-block-relay/signing"
+	"github.com/attestantio/go-block-relay/types"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ValidatorRegistrations handles validator registrations.
+// Each registration is handled independently; the returned strings
+// describe the registrations that were rejected.
+func (s *Service) ValidatorRegistrations(ctx context.Context,
+	registrations []*types.SignedValidatorRegistration,
+) (
+	[]string,
+	error,
+) {
+	ctx, span := otel.Tracer("attestantio.go-block-relay.services.validatorregistrar.standard").Start(ctx, "ValidatorRegistrations", trace.WithAttributes(
+		attribute.Int("registrations", len(registrations)),
+	))
+	defer span.End()
+
+	log := loggers.WithRequestID(ctx, s.log)
+
+	registrationErrors := make([]string, 0)
+
+	maxTimestamp := time.Now().Add(s.futureTimestampTolerance)
+
+	candidates := make([]*types.SignedValidatorRegistration, 0, len(registrations))
+	for i, registration := range registrations {
+		if registration == nil || registration.Message == nil {
+			registrationErrors = append(registrationErrors, fmt.Sprintf("registration %d: no message", i))
+
+			continue
+		}
+
+		if registration.Message.Timestamp.After(maxTimestamp) {
+			registrationErrors = append(registrationErrors,
+				fmt.Sprintf("%#x: timestamp too far in the future", registration.Message.Pubkey),
+			)
+
+			continue
+		}
+
+		candidates = append(candidates, registration)
+	}
+
+	verificationErrors := s.verifySignatures(candidates)
+
+	valid := make([]*types.SignedValidatorRegistration, 0, len(candidates))
+	for i, registration := range candidates {
+		if verificationErrors[i] != nil {
+			registrationErrors = append(registrationErrors,
+				fmt.Sprintf("%#x: %v", registration.Message.Pubkey, verificationErrors[i]),
+			)
+
+			continue
+		}
+
+		valid = append(valid, registration)
+	}
+
+	s.registrationsMu.Lock()
+	for _, registration := range valid {
+		existing, exists := s.registrations[registration.Message.Pubkey]
+		if exists && registration.Message.Timestamp.Before(existing.Message.Timestamp) {
+			registrationErrors = append(registrationErrors,
+				fmt.Sprintf("%#x: timestamp older than existing registration", registration.Message.Pubkey),
+			)
+
+			continue
+		}
+
+		s.registrations[registration.Message.Pubkey] = registration
+	}
+	s.registrationsMu.Unlock()
+
+	span.SetAttributes(attribute.Int("rejected", len(registrationErrors)))
+	log.Trace().
+		Int("registrations", len(registrations)).
+		Int("rejected", len(registrationErrors)).
+		Msg("Handled validator registrations")
+
+	return registrationErrors, nil
+}
+
+// verifySignatures verifies the signatures of registrations, spreading the work across
+// the service's verification workers.  The returned errors are in the same order as the
+// registrations, with nil for a valid signature.
+func (s *Service) verifySignatures(registrations []*types.SignedValidatorRegistration) []error {
+	verificationErrors := make([]error, len(registrations))
+
+	workers := min(s.verificationWorkers, len(registrations))
+	if workers <= 1 {
+		for i, registration := range registrations {
+			verificationErrors[i] = s.verifySignature(registration)
+		}
+
+		return verificationErrors
+	}
+
+	indices := make(chan int)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Go(func() {
+			for i := range indices {
+				verificationErrors[i] = s.verifySignature(registrations[i])
+			}
+		})
+	}
+
+	for i := range registrations {
+		indices <- i
+	}
+	close(indices)
+	wg.Wait()
+
+	return verificationErrors
+}
+
+// verifySignature verifies the signature of a registration in the builder domain.
+func (s *Service) verifySignature(registration *types.SignedValidatorRegistration) error {
+	root, err := registration.Message.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate registration root")
+	}
+
+	signingRoot, err := signing.ComputeSigningRoot(root, s.builderDomain)
+	if err != nil {
+		return err
+	}
+
+	verified, err := signing.Verify(registration.Message.Pubkey, signingRoot, registration.Signature)
+	if err != nil {
+		return err
+	}
+	if !verified {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
+
+// ValidatorRegistration provides the latest registration for the given public key.
+// If there is no registration for the public key this returns nil.
+func (s *Service) ValidatorRegistration(_ context.Context,
+	pubkey phase0.BLSPubKey,
+) (
+	*types.SignedValidatorRegistration,
+	error,
+) {
+	s.registrationsMu.RLock()
+	registration := s.registrations[pubkey]
+	s.registrationsMu.RUnlock()
+
+	return registration, nil
+}
+
+// AllValidatorRegistrations provides the latest registration for every registered validator.
+// Registrations are returned in no particular order.
+func (s *Service) AllValidatorRegistrations(_ context.Context) ([]*types.SignedValidatorRegistration, error) {
+	s.registrationsMu.RLock()
+	registrations := make([]*types.SignedValidatorRegistration, 0, len(s.registrations))
+	for _, registration := range s.registrations {
+		registrations = append(registrations, registration)
+	}
+	s.registrationsMu.RUnlock()
+
+	return registrations, nil
+}
@@ -0,0 +1,340 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-block-relay/services/validatorregistrar/standard"
+	"github.com/attestantio/go-block-relay/signing"
+	"github.com/attestantio/go-block-relay/types"
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// secretKey provides a deterministic secret key for the given index.
+func secretKey(t *testing.T, index byte) []byte {
+	t.Helper()
+
+	return blst.KeyGen(bytes.Repeat([]byte{index}, 32)).Serialize()
+}
+
+// pubkey provides the public key for the given index.
+func pubkey(t *testing.T, index byte) phase0.BLSPubKey {
+	t.Helper()
+
+	pubkey, err := signing.PublicKey(secretKey(t, index))
+	require.NoError(t, err)
+
+	return pubkey
+}
+
+// registration creates a registration signed by the key for the given index.
+func registration(t *testing.T, index byte, feeRecipient byte, timestamp time.Time) *types.SignedValidatorRegistration {
+	t.Helper()
+
+	registration := &types.SignedValidatorRegistration{
+		Message: &types.ValidatorRegistration{
+			FeeRecipient: bellatrix.ExecutionAddress{feeRecipient},
+			GasLimit:     30000000,
+			Timestamp:    timestamp,
+			Pubkey:       pubkey(t, index),
+		},
+	}
+
+	registration.Signature = signed(t, registration.Message, index, phase0.Version{})
+
+	return registration
+}
+
+// signed signs a registration with the key for the given index.
+func signed(t *testing.T, message *types.ValidatorRegistration, index byte, genesisForkVersion phase0.Version) phase0.BLSSignature {
+	t.Helper()
+
+	domain, err := signing.ComputeBuilderDomain(genesisForkVersion)
+	require.NoError(t, err)
+	root, err := message.HashTreeRoot()
+	require.NoError(t, err)
+	signingRoot, err := signing.ComputeSigningRoot(root, domain)
+	require.NoError(t, err)
+	signature, err := signing.Sign(secretKey(t, index), signingRoot)
+	require.NoError(t, err)
+
+	return signature
+}
+
+// resigned replaces the signature of a registration with one from the key for the given index.
+func resigned(t *testing.T, registration *types.SignedValidatorRegistration, index byte) *types.SignedValidatorRegistration {
+	t.Helper()
+
+	registration.Signature = signed(t, registration.Message, index, phase0.Version{})
+
+	return registration
+}
+
+func TestValidatorRegistrations(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Now().Truncate(time.Second)
+
+	tests := []struct {
+		name          string
+		existing      []*types.SignedValidatorRegistration
+		registrations []*types.SignedValidatorRegistration
+		errs          []string
+		feeRecipients map[byte]byte
+	}{
+		{
+			name:          "Empty",
+			registrations: []*types.SignedValidatorRegistration{},
+			errs:          []string{},
+		},
+		{
+			name: "MessageMissing",
+			registrations: []*types.SignedValidatorRegistration{
+				{},
+			},
+			errs: []string{"registration 0: no message"},
+		},
+		{
+			name: "SignatureInvalid",
+			registrations: []*types.SignedValidatorRegistration{
+				resigned(t, registration(t, 0x01, 0x01, now), 0x02),
+				registration(t, 0x02, 0x02, now),
+			},
+			errs: []string{
+				fmt.Sprintf("%#x: invalid signature", pubkey(t, 0x01)),
+			},
+			feeRecipients: map[byte]byte{0x02: 0x02},
+		},
+		{
+			name: "SignatureMissing",
+			registrations: []*types.SignedValidatorRegistration{
+				{
+					Message: registration(t, 0x01, 0x01, now).Message,
+				},
+			},
+			errs: []string{
+				fmt.Sprintf("%#x: invalid signature", pubkey(t, 0x01)),
+			},
+		},
+		{
+			name: "FeeRecipientChanged",
+			registrations: []*types.SignedValidatorRegistration{
+				func() *types.SignedValidatorRegistration {
+					registration := registration(t, 0x01, 0x01, now)
+					registration.Message.FeeRecipient = bellatrix.ExecutionAddress{0x02}
+
+					return registration
+				}(),
+			},
+			errs: []string{
+				fmt.Sprintf("%#x: invalid signature", pubkey(t, 0x01)),
+			},
+		},
+		{
+			name: "New",
+			registrations: []*types.SignedValidatorRegistration{
+				registration(t, 0x01, 0x01, now),
+				registration(t, 0x02, 0x02, now),
+			},
+			errs:          []string{},
+			feeRecipients: map[byte]byte{0x01: 0x01, 0x02: 0x02},
+		},
+		{
+			name: "Newer",
+			existing: []*types.SignedValidatorRegistration{
+				registration(t, 0x01, 0x01, now.Add(-time.Minute)),
+			},
+			registrations: []*types.SignedValidatorRegistration{
+				registration(t, 0x01, 0x02, now),
+			},
+			errs:          []string{},
+			feeRecipients: map[byte]byte{0x01: 0x02},
+		},
+		{
+			name: "SameTimestamp",
+			existing: []*types.SignedValidatorRegistration{
+				registration(t, 0x01, 0x01, now),
+			},
+			registrations: []*types.SignedValidatorRegistration{
+				registration(t, 0x01, 0x02, now),
+			},
+			errs:          []string{},
+			feeRecipients: map[byte]byte{0x01: 0x02},
+		},
+		{
+			name: "Older",
+			existing: []*types.SignedValidatorRegistration{
+				registration(t, 0x01, 0x01, now),
+			},
+			registrations: []*types.SignedValidatorRegistration{
+				registration(t, 0x01, 0x02, now.Add(-time.Minute)),
+				registration(t, 0x02, 0x02, now),
+			},
+			errs: []string{
+				fmt.Sprintf("%#x: timestamp older than existing registration", pubkey(t, 0x01)),
+			},
+			feeRecipients: map[byte]byte{0x01: 0x01, 0x02: 0x02},
+		},
+		{
+			name: "Future",
+			registrations: []*types.SignedValidatorRegistration{
+				registration(t, 0x01, 0x01, now.Add(time.Hour)),
+				registration(t, 0x02, 0x02, now.Add(time.Second)),
+			},
+			errs: []string{
+				fmt.Sprintf("%#x: timestamp too far in the future", pubkey(t, 0x01)),
+			},
+			feeRecipients: map[byte]byte{0x02: 0x02},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s, err := standard.New(ctx, standard.WithLogLevel(zerolog.Disabled))
+			require.NoError(t, err)
+
+			if len(test.existing) > 0 {
+				errs, err := s.ValidatorRegistrations(ctx, test.existing)
+				require.NoError(t, err)
+				require.Empty(t, errs)
+			}
+
+			errs, err := s.ValidatorRegistrations(ctx, test.registrations)
+			require.NoError(t, err)
+			require.Equal(t, test.errs, errs)
+
+			all, err := s.AllValidatorRegistrations(ctx)
+			require.NoError(t, err)
+			require.Len(t, all, len(test.feeRecipients))
+
+			for index, feeRecipient := range test.feeRecipients {
+				registration, err := s.ValidatorRegistration(ctx, pubkey(t, index))
+				require.NoError(t, err)
+				require.NotNil(t, registration)
+				require.Equal(t, bellatrix.ExecutionAddress{feeRecipient}, registration.Message.FeeRecipient)
+			}
+		})
+	}
+}
+
+func TestValidatorRegistrationMissing(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := standard.New(ctx, standard.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	registration, err := s.ValidatorRegistration(ctx, phase0.BLSPubKey{0x01})
+	require.NoError(t, err)
+	require.Nil(t, registration)
+}
+
+func TestValidatorRegistrationsConcurrent(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := standard.New(ctx, standard.WithLogLevel(zerolog.Disabled))
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	batches := make([][]*types.SignedValidatorRegistration, 8)
+	for i := range batches {
+		batches[i] = make([]*types.SignedValidatorRegistration, 0, 32)
+		for j := range 32 {
+			batches[i] = append(batches[i], registration(t, byte(j), byte(i), now))
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, batch := range batches {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs, err := s.ValidatorRegistrations(ctx, batch)
+			assert.NoError(t, err)
+			assert.Empty(t, errs)
+		}()
+	}
+	wg.Wait()
+
+	all, err := s.AllValidatorRegistrations(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 32)
+}
+
+func TestValidatorRegistrationsVerificationWorkers(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithVerificationWorkers(4),
+	)
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	registrations := make([]*types.SignedValidatorRegistration, 0, 32)
+	expected := make([]string, 0)
+	for i := range 32 {
+		registration := registration(t, byte(i), 0x01, now)
+		if i%5 == 0 {
+			// Signed by a different key.
+			registration.Signature = signed(t, registration.Message, byte(i+1), phase0.Version{})
+			expected = append(expected, fmt.Sprintf("%#x: invalid signature", registration.Message.Pubkey))
+		}
+		registrations = append(registrations, registration)
+	}
+
+	errs, err := s.ValidatorRegistrations(ctx, registrations)
+	require.NoError(t, err)
+	require.Equal(t, expected, errs)
+
+	all, err := s.AllValidatorRegistrations(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 32-len(expected))
+}
+
+func TestValidatorRegistrationsGenesisForkVersion(t *testing.T) {
+	ctx := context.Background()
+
+	genesisForkVersion := phase0.Version{0x10, 0x00, 0x09, 0x10}
+	s, err := standard.New(ctx,
+		standard.WithLogLevel(zerolog.Disabled),
+		standard.WithGenesisForkVersion(genesisForkVersion),
+	)
+	require.NoError(t, err)
+
+	// Registration signed for mainnet should be rejected.
+	mainnetRegistration := registration(t, 0x01, 0x01, time.Now())
+	errs, err := s.ValidatorRegistrations(ctx, []*types.SignedValidatorRegistration{mainnetRegistration})
+	require.NoError(t, err)
+	require.Equal(t, []string{fmt.Sprintf("%#x: invalid signature", pubkey(t, 0x01))}, errs)
+
+	// Registration signed for the configured fork version should be accepted.
+	registration := registration(t, 0x01, 0x01, time.Now())
+	registration.Signature = signed(t, registration.Message, 0x01, genesisForkVersion)
+	errs, err = s.ValidatorRegistrations(ctx, []*types.SignedValidatorRegistration{registration})
+	require.NoError(t, err)
+	require.Empty(t, errs)
+}
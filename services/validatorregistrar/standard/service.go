@@ -0,0 +1,67 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package standard
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/attestantio/go-block-relay/signing"
+	"github.com/attestantio/go-block-relay/types"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog"
+	zerologger "github.com/rs/zerolog/log"
+)
+
+// Service is a validator registrar that holds the latest registration for each validator in memory.
+type Service struct {
+	log                      zerolog.Logger
+	futureTimestampTolerance time.Duration
+	builderDomain            phase0.Domain
+	verificationWorkers      int
+
+	registrationsMu sync.RWMutex
+	registrations   map[phase0.BLSPubKey]*types.SignedValidatorRegistration
+}
+
+// New creates a new validator registrar.
+func New(_ context.Context, params ...Parameter) (*Service, error) {
+	parameters, err := parseAndCheckParameters(params...)
+	if err != nil {
+		return nil, errors.Wrap(err, "problem with parameters")
+	}
+
+	// Set logging.
+	log := zerologger.With().Str("service", "validatorregistrar").Str("impl", "standard").Logger()
+	if parameters.logLevel != log.GetLevel() {
+		log = log.Level(parameters.logLevel)
+	}
+
+	builderDomain, err := signing.ComputeBuilderDomain(parameters.genesisForkVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Service{
+		log:                      log,
+		futureTimestampTolerance: parameters.futureTimestampTolerance,
+		builderDomain:            builderDomain,
+		verificationWorkers:      parameters.verificationWorkers,
+		registrations:            make(map[phase0.BLSPubKey]*types.SignedValidatorRegistration),
+	}
+
+	return s, nil
+}
@@ -0,0 +1,55 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing
+
+import (
+	"github.com/attestantio/go-builder-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+)
+
+// VerifyBuilderBid verifies the signature of a builder bid against the
+// public key contained in the bid.
+func VerifyBuilderBid(bid *spec.VersionedSignedBuilderBid, domain phase0.Domain) error {
+	builder, err := bid.Builder()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain builder")
+	}
+
+	root, err := bid.MessageHashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate bid root")
+	}
+
+	signingRoot, err := ComputeSigningRoot(root, domain)
+	if err != nil {
+		return err
+	}
+
+	signature, err := bid.Signature()
+	if err != nil {
+		return errors.Wrap(err, "failed to obtain signature")
+	}
+
+	verified, err := Verify(builder, signingRoot, signature)
+	if err != nil {
+		return errors.Wrap(err, "failed to verify signature")
+	}
+
+	if !verified {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package signing provides functions for signing and verifying objects
+// in the builder domain.
+package signing
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/pkg/errors"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// DomainApplicationBuilder is the domain type for builder API objects.
+var DomainApplicationBuilder = phase0.DomainType{0x00, 0x00, 0x00, 0x01}
+
+// DomainBeaconProposer is the domain type for beacon block proposals.
+var DomainBeaconProposer = phase0.DomainType{0x00, 0x00, 0x00, 0x00}
+
+// dst is the domain separation tag for Ethereum BLS signatures.
+var dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// ComputeBuilderDomain computes the builder domain for the given genesis fork version.
+// The builder domain always uses a zero genesis validators root.
+func ComputeBuilderDomain(genesisForkVersion phase0.Version) (phase0.Domain, error) {
+	return ComputeDomain(DomainApplicationBuilder, genesisForkVersion, phase0.Root{})
+}
+
+// ComputeDomain computes the domain for the given domain type, fork version and genesis validators root.
+func ComputeDomain(domainType phase0.DomainType,
+	forkVersion phase0.Version,
+	genesisValidatorsRoot phase0.Root,
+) (
+	phase0.Domain,
+	error,
+) {
+	forkData := &phase0.ForkData{
+		CurrentVersion:        forkVersion,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}
+	root, err := forkData.HashTreeRoot()
+	if err != nil {
+		return phase0.Domain{}, errors.Wrap(err, "failed to calculate fork data root")
+	}
+
+	var domain phase0.Domain
+	copy(domain[:], domainType[:])
+	copy(domain[4:], root[:28])
+
+	return domain, nil
+}
+
+// ComputeSigningRoot computes the signing root for an object root in a domain.
+func ComputeSigningRoot(objectRoot phase0.Root, domain phase0.Domain) (phase0.Root, error) {
+	signingData := &phase0.SigningData{
+		ObjectRoot: objectRoot,
+		Domain:     domain,
+	}
+	root, err := signingData.HashTreeRoot()
+	if err != nil {
+		return phase0.Root{}, errors.Wrap(err, "failed to calculate signing root")
+	}
+
+	return root, nil
+}
+
+// Verify verifies a signature over a signing root for a public key.
+func Verify(pubkey phase0.BLSPubKey,
+	signingRoot phase0.Root,
+	signature phase0.BLSSignature,
+) (
+	bool,
+	error,
+) {
+	pk := new(blst.P1Affine).Uncompress(pubkey[:])
+	if pk == nil {
+		return false, errors.New("invalid public key")
+	}
+
+	sig := new(blst.P2Affine).Uncompress(signature[:])
+	if sig == nil {
+		return false, errors.New("invalid signature")
+	}
+
+	return sig.Verify(true, pk, true, signingRoot[:], dst), nil
+}
+
+// Sign signs a signing root with a secret key.
+// The secret key must be the 32-byte big-endian representation of the key.
+func Sign(secretKey []byte, signingRoot phase0.Root) (phase0.BLSSignature, error) {
+	sk := new(blst.SecretKey).Deserialize(secretKey)
+	if sk == nil {
+		return phase0.BLSSignature{}, errors.New("invalid secret key")
+	}
+	defer sk.Zeroize()
+
+	sig := new(blst.P2Affine).Sign(sk, signingRoot[:], dst)
+
+	var signature phase0.BLSSignature
+	copy(signature[:], sig.Compress())
+
+	return signature, nil
+}
+
+// PublicKey provides the public key for a secret key.
+// The secret key must be the 32-byte big-endian representation of the key.
+func PublicKey(secretKey []byte) (phase0.BLSPubKey, error) {
+	sk := new(blst.SecretKey).Deserialize(secretKey)
+	if sk == nil {
+		return phase0.BLSPubKey{}, errors.New("invalid secret key")
+	}
+	defer sk.Zeroize()
+
+	var pubkey phase0.BLSPubKey
+	copy(pubkey[:], new(blst.P1Affine).From(sk).Compress())
+
+	return pubkey, nil
+}
@@ -0,0 +1,161 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signing_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/attestantio/go-block-relay/signing"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/stretchr/testify/require"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+func TestComputeBuilderDomain(t *testing.T) {
+	tests := []struct {
+		name               string
+		genesisForkVersion phase0.Version
+		expected           phase0.Domain
+	}{
+		{
+			name:               "Mainnet",
+			genesisForkVersion: phase0.Version{0x00, 0x00, 0x00, 0x00},
+			expected: phase0.Domain{
+				0x00, 0x00, 0x00, 0x01, 0xf5, 0xa5, 0xfd, 0x42, 0xd1, 0x6a, 0x20, 0x30, 0x27, 0x98, 0xef, 0x6e,
+				0xd3, 0x09, 0x97, 0x9b, 0x43, 0x00, 0x3d, 0x23, 0x20, 0xd9, 0xf0, 0xe8, 0xea, 0x98, 0x31, 0xa9,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			domain, err := signing.ComputeBuilderDomain(test.genesisForkVersion)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, domain)
+		})
+	}
+}
+
+func TestComputeDomain(t *testing.T) {
+	tests := []struct {
+		name                  string
+		domainType            phase0.DomainType
+		forkVersion           phase0.Version
+		genesisValidatorsRoot phase0.Root
+		expected              phase0.Domain
+	}{
+		{
+			name:        "Builder",
+			domainType:  signing.DomainApplicationBuilder,
+			forkVersion: phase0.Version{0x00, 0x00, 0x00, 0x00},
+			expected: phase0.Domain{
+				0x00, 0x00, 0x00, 0x01, 0xf5, 0xa5, 0xfd, 0x42, 0xd1, 0x6a, 0x20, 0x30, 0x27, 0x98, 0xef, 0x6e,
+				0xd3, 0x09, 0x97, 0x9b, 0x43, 0x00, 0x3d, 0x23, 0x20, 0xd9, 0xf0, 0xe8, 0xea, 0x98, 0x31, 0xa9,
+			},
+		},
+		{
+			name:        "MainnetDenebProposer",
+			domainType:  signing.DomainBeaconProposer,
+			forkVersion: phase0.Version{0x04, 0x00, 0x00, 0x00},
+			genesisValidatorsRoot: phase0.Root{
+				0x4b, 0x36, 0x3d, 0xb9, 0x4e, 0x28, 0x61, 0x20, 0xd7, 0x6e, 0xb9, 0x05, 0x34, 0x0f, 0xdd, 0x4e,
+				0x54, 0xbf, 0xe9, 0xf0, 0x6b, 0xf3, 0x3f, 0xf6, 0xcf, 0x5a, 0xd2, 0x7f, 0x51, 0x1b, 0xfe, 0x95,
+			},
+			expected: phase0.Domain{
+				0x00, 0x00, 0x00, 0x00, 0x6a, 0x95, 0xa1, 0xa9, 0x67, 0x85, 0x5d, 0x67, 0x6d, 0x48, 0xbe, 0x69,
+				0x88, 0x3b, 0x71, 0x26, 0x07, 0xf9, 0x52, 0xd5, 0x19, 0x8d, 0x0f, 0x56, 0x77, 0x56, 0x46, 0x36,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			domain, err := signing.ComputeDomain(test.domainType, test.forkVersion, test.genesisValidatorsRoot)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, domain)
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	secretKey := blst.KeyGen(bytes.Repeat([]byte{0x01}, 32)).Serialize()
+	pubkey, err := signing.PublicKey(secretKey)
+	require.NoError(t, err)
+
+	otherSecretKey := blst.KeyGen(bytes.Repeat([]byte{0x02}, 32)).Serialize()
+	otherPubkey, err := signing.PublicKey(otherSecretKey)
+	require.NoError(t, err)
+
+	signingRoot := phase0.Root{0x01}
+	signature, err := signing.Sign(secretKey, signingRoot)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		pubkey      phase0.BLSPubKey
+		signingRoot phase0.Root
+		signature   phase0.BLSSignature
+		verified    bool
+		err         string
+	}{
+		{
+			name:        "PubkeyInvalid",
+			pubkey:      phase0.BLSPubKey{0x01},
+			signingRoot: signingRoot,
+			signature:   signature,
+			err:         "invalid public key",
+		},
+		{
+			name:        "SignatureInvalid",
+			pubkey:      pubkey,
+			signingRoot: signingRoot,
+			signature:   phase0.BLSSignature{0x01},
+			err:         "invalid signature",
+		},
+		{
+			name:        "PubkeyIncorrect",
+			pubkey:      otherPubkey,
+			signingRoot: signingRoot,
+			signature:   signature,
+			verified:    false,
+		},
+		{
+			name:        "SigningRootIncorrect",
+			pubkey:      pubkey,
+			signingRoot: phase0.Root{0x02},
+			signature:   signature,
+			verified:    false,
+		},
+		{
+			name:        "Good",
+			pubkey:      pubkey,
+			signingRoot: signingRoot,
+			signature:   signature,
+			verified:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verified, err := signing.Verify(test.pubkey, test.signingRoot, test.signature)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.verified, verified)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/attestantio/go-block-relay/signing"
+	"github.com/attestantio/go-builder-client/api"
+	"github.com/attestantio/go-builder-client/api/electra"
+	"github.com/attestantio/go-builder-client/spec"
+	consensusspec "github.com/attestantio/go-eth2-client/spec"
+	"github.com/attestantio/go-eth2-client/spec/deneb"
+	consensuselectra "github.com/attestantio/go-eth2-client/spec/electra"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+// Provider is an upstream builder bid provider that returns signed bids.
+type Provider struct {
+	name      string
+	secretKey []byte
+	// PubkeyValue is the public key of the provider.
+	PubkeyValue phase0.BLSPubKey
+	// Value is the value of bids returned by the provider.
+	Value uint64
+	// BlockHash is the block hash of bids returned by the provider.
+	BlockHash phase0.Hash32
+	// Delay is the time the provider waits before returning a bid.
+	Delay time.Duration
+	// Err is returned in place of a bid, if set.
+	Err error
+	// Tamper modifies bids after they have been signed, if set.
+	Tamper func(bid *electra.SignedBuilderBid)
+}
+
+// NewProvider creates a provider with a deterministic key derived from the given seed.
+func NewProvider(t *testing.T, name string, seed byte, value uint64) *Provider {
+	t.Helper()
+
+	secretKey := SecretKey(seed)
+	pubkey, err := signing.PublicKey(secretKey)
+	require.NoError(t, err)
+
+	return &Provider{
+		name:        name,
+		secretKey:   secretKey,
+		PubkeyValue: pubkey,
+		Value:       value,
+		BlockHash:   phase0.Hash32{seed},
+	}
+}
+
+// SecretKey provides a deterministic secret key derived from the given seed.
+func SecretKey(seed byte) []byte {
+	return blst.KeyGen(bytes.Repeat([]byte{seed}, 32)).Serialize()
+}
+
+// Name returns the name of the provider.
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// Address returns the address of the provider.
+func (p *Provider) Address() string {
+	return "http://" + p.name
+}
+
+// Pubkey returns the public key of the provider.
+func (p *Provider) Pubkey() *phase0.BLSPubKey {
+	return &p.PubkeyValue
+}
+
+// BuilderBid returns a signed mainnet bid for the requested parent hash.
+func (p *Provider) BuilderBid(ctx context.Context,
+	opts *api.BuilderBidOpts,
+) (
+	*api.Response[*spec.VersionedSignedBuilderBid],
+	error,
+) {
+	if p.Delay > 0 {
+		select {
+		case <-time.After(p.Delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if p.Err != nil {
+		return nil, p.Err
+	}
+
+	bid := &electra.SignedBuilderBid{
+		Message: &electra.BuilderBid{
+			Header: &deneb.ExecutionPayloadHeader{
+				ParentHash:    opts.ParentHash,
+				BlockHash:     p.BlockHash,
+				BaseFeePerGas: uint256.NewInt(0),
+			},
+			BlobKZGCommitments: make([]deneb.KZGCommitment, 0),
+			ExecutionRequests:  &consensuselectra.ExecutionRequests{},
+			Value:              uint256.NewInt(p.Value),
+			Pubkey:             p.PubkeyValue,
+		},
+	}
+
+	domain, err := signing.ComputeBuilderDomain(phase0.Version{})
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := bid.Message.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	signingRoot, err := signing.ComputeSigningRoot(root, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	bid.Signature, err = signing.Sign(p.secretKey, signingRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.Tamper != nil {
+		p.Tamper(bid)
+	}
+
+	return &api.Response[*spec.VersionedSignedBuilderBid]{
+		Data: &spec.VersionedSignedBuilderBid{
+			Version: consensusspec.DataVersionElectra,
+			Electra: bid,
+		},
+	}, nil
+}
+
+// PayloadProvider is an upstream builder bid provider that also provides the
+// execution payloads behind its bids.
+type PayloadProvider struct {
+	*Provider
+	// Payloads are the execution payloads provided, by block hash.
+	Payloads map[phase0.Hash32]*api.VersionedSubmitBlindedBlockResponse
+}
+
+// ExecutionPayload provides the execution payload for the bid with the given block hash.
+// It returns nil if the payload is not known.
+func (p *PayloadProvider) ExecutionPayload(_ context.Context,
+	_ phase0.Slot,
+	blockHash phase0.Hash32,
+) (
+	*api.VersionedSubmitBlindedBlockResponse,
+	error,
+) {
+	if p.Err != nil {
+		return nil, p.Err
+	}
+
+	return p.Payloads[blockHash], nil
+}
@@ -0,0 +1,290 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/go-eth2-client/spec/bellatrix"
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+	"github.com/goccy/go-yaml"
+	"github.com/holiman/uint256"
+	"github.com/pkg/errors"
+)
+
+// BidTrace represents a BidTraceV2, as served by the relay data API.
+type BidTrace struct {
+	Slot                 phase0.Slot
+	ParentHash           phase0.Hash32
+	BlockHash            phase0.Hash32
+	BuilderPubkey        phase0.BLSPubKey
+	ProposerPubkey       phase0.BLSPubKey
+	ProposerFeeRecipient bellatrix.ExecutionAddress
+	GasLimit             uint64
+	GasUsed              uint64
+	Value                *uint256.Int
+	BlockNumber          uint64
+	NumTx                uint64
+}
+
+// bidTraceJSON is the spec representation of the struct.
+type bidTraceJSON struct {
+	Slot                 string `json:"slot"`
+	ParentHash           string `json:"parent_hash"`
+	BlockHash            string `json:"block_hash"`
+	BuilderPubkey        string `json:"builder_pubkey"`
+	ProposerPubkey       string `json:"proposer_pubkey"`
+	ProposerFeeRecipient string `json:"proposer_fee_recipient"`
+	GasLimit             string `json:"gas_limit"`
+	GasUsed              string `json:"gas_used"`
+	Value                string `json:"value"`
+	BlockNumber          string `json:"block_number"`
+	NumTx                string `json:"num_tx"`
+}
+
+// bidTraceYAML is the spec representation of the struct.
+type bidTraceYAML struct {
+	Slot                 uint64 `yaml:"slot"`
+	ParentHash           string `yaml:"parent_hash"`
+	BlockHash            string `yaml:"block_hash"`
+	BuilderPubkey        string `yaml:"builder_pubkey"`
+	ProposerPubkey       string `yaml:"proposer_pubkey"`
+	ProposerFeeRecipient string `yaml:"proposer_fee_recipient"`
+	GasLimit             uint64 `yaml:"gas_limit"`
+	GasUsed              uint64 `yaml:"gas_used"`
+	Value                string `yaml:"value"`
+	BlockNumber          uint64 `yaml:"block_number"`
+	NumTx                uint64 `yaml:"num_tx"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (b *BidTrace) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.toJSON())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BidTrace) UnmarshalJSON(input []byte) error {
+	var data bidTraceJSON
+
+	err := json.Unmarshal(input, &data)
+	if err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return b.unpack(&data)
+}
+
+// String returns a string version of the structure.
+func (b *BidTrace) String() string {
+	data, err := yaml.Marshal(b)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (b *BidTrace) UnmarshalYAML(input []byte) error {
+	// We unmarshal to the JSON struct to save on duplicate code.
+	var data bidTraceJSON
+
+	err := yaml.Unmarshal(input, &data)
+	if err != nil {
+		return err
+	}
+
+	return b.unpack(&data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (b *BidTrace) MarshalYAML() ([]byte, error) {
+	yamlBytes, err := yaml.MarshalWithOptions(&bidTraceYAML{
+		Slot:                 uint64(b.Slot),
+		ParentHash:           fmt.Sprintf("%#x", b.ParentHash),
+		BlockHash:            fmt.Sprintf("%#x", b.BlockHash),
+		BuilderPubkey:        fmt.Sprintf("%#x", b.BuilderPubkey),
+		ProposerPubkey:       fmt.Sprintf("%#x", b.ProposerPubkey),
+		ProposerFeeRecipient: fmt.Sprintf("%#x", b.ProposerFeeRecipient),
+		GasLimit:             b.GasLimit,
+		GasUsed:              b.GasUsed,
+		Value:                b.valueString(),
+		BlockNumber:          b.BlockNumber,
+		NumTx:                b.NumTx,
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+func (b *BidTrace) toJSON() *bidTraceJSON {
+	return &bidTraceJSON{
+		Slot:                 fmt.Sprintf("%d", b.Slot),
+		ParentHash:           fmt.Sprintf("%#x", b.ParentHash),
+		BlockHash:            fmt.Sprintf("%#x", b.BlockHash),
+		BuilderPubkey:        fmt.Sprintf("%#x", b.BuilderPubkey),
+		ProposerPubkey:       fmt.Sprintf("%#x", b.ProposerPubkey),
+		ProposerFeeRecipient: fmt.Sprintf("%#x", b.ProposerFeeRecipient),
+		GasLimit:             fmt.Sprintf("%d", b.GasLimit),
+		GasUsed:              fmt.Sprintf("%d", b.GasUsed),
+		Value:                b.valueString(),
+		BlockNumber:          fmt.Sprintf("%d", b.BlockNumber),
+		NumTx:                fmt.Sprintf("%d", b.NumTx),
+	}
+}
+
+func (b *BidTrace) valueString() string {
+	if b.Value == nil {
+		return "0"
+	}
+
+	return b.Value.Dec()
+}
+
+func (b *BidTrace) unpack(data *bidTraceJSON) error {
+	if data.Slot == "" {
+		return errors.New("slot missing")
+	}
+
+	slot, err := strconv.ParseUint(data.Slot, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for slot")
+	}
+
+	b.Slot = phase0.Slot(slot)
+
+	err = unpackHash(data.ParentHash, "parent hash", &b.ParentHash)
+	if err != nil {
+		return err
+	}
+
+	err = unpackHash(data.BlockHash, "block hash", &b.BlockHash)
+	if err != nil {
+		return err
+	}
+
+	err = unpackPubkey(data.BuilderPubkey, "builder public key", &b.BuilderPubkey)
+	if err != nil {
+		return err
+	}
+
+	err = unpackPubkey(data.ProposerPubkey, "proposer public key", &b.ProposerPubkey)
+	if err != nil {
+		return err
+	}
+
+	if data.ProposerFeeRecipient == "" {
+		return errors.New("proposer fee recipient missing")
+	}
+
+	feeRecipient, err := hex.DecodeString(strings.TrimPrefix(data.ProposerFeeRecipient, "0x"))
+	if err != nil {
+		return errors.Wrap(err, "invalid value for proposer fee recipient")
+	}
+
+	if len(feeRecipient) != bellatrix.ExecutionAddressLength {
+		return errors.New("incorrect length for proposer fee recipient")
+	}
+
+	copy(b.ProposerFeeRecipient[:], feeRecipient)
+
+	b.GasLimit, err = unpackUint64(data.GasLimit, "gas limit")
+	if err != nil {
+		return err
+	}
+
+	b.GasUsed, err = unpackUint64(data.GasUsed, "gas used")
+	if err != nil {
+		return err
+	}
+
+	if data.Value == "" {
+		return errors.New("value missing")
+	}
+
+	b.Value, err = uint256.FromDecimal(data.Value)
+	if err != nil {
+		return errors.Wrap(err, "invalid value for value")
+	}
+
+	b.BlockNumber, err = unpackUint64(data.BlockNumber, "block number")
+	if err != nil {
+		return err
+	}
+
+	b.NumTx, err = unpackUint64(data.NumTx, "number of transactions")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func unpackHash(input string, name string, hash *phase0.Hash32) error {
+	if input == "" {
+		return fmt.Errorf("%s missing", name)
+	}
+
+	data, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("invalid value for %s", name))
+	}
+
+	if len(data) != phase0.Hash32Length {
+		return fmt.Errorf("incorrect length for %s", name)
+	}
+
+	copy(hash[:], data)
+
+	return nil
+}
+
+func unpackPubkey(input string, name string, pubkey *phase0.BLSPubKey) error {
+	if input == "" {
+		return fmt.Errorf("%s missing", name)
+	}
+
+	data, err := hex.DecodeString(strings.TrimPrefix(input, "0x"))
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("invalid value for %s", name))
+	}
+
+	if len(data) != phase0.PublicKeyLength {
+		return fmt.Errorf("incorrect length for %s", name)
+	}
+
+	copy(pubkey[:], data)
+
+	return nil
+}
+
+func unpackUint64(input string, name string) (uint64, error) {
+	if input == "" {
+		return 0, fmt.Errorf("%s missing", name)
+	}
+
+	val, err := strconv.ParseUint(input, 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, fmt.Sprintf("invalid value for %s", name))
+	}
+
+	return val, nil
+}
@@ -0,0 +1,183 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-block-relay/types"
+	"github.com/goccy/go-yaml"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestBidTraceJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte(`[]`),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type types.bidTraceJSON",
+		},
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50"}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "SlotWrongType",
+			input: []byte(`{"slot":true,"parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50"}`),
+			err:   "invalid JSON: json: cannot unmarshal bool into Go struct field bidTraceJSON.slot of type string",
+		},
+		{
+			name:  "SlotInvalid",
+			input: []byte(`{"slot":"-1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50"}`),
+			err:   "invalid value for slot: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "ParentHashMissing",
+			input: []byte(`{"slot":"1","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50"}`),
+			err:   "parent hash missing",
+		},
+		{
+			name:  "ParentHashInvalid",
+			input: []byte(`{"slot":"1","parent_hash":"invalid","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50"}`),
+			err:   "invalid value for parent hash: encoding/hex: invalid byte: U+0069 'i'",
+		},
+		{
+			name:  "ParentHashShort",
+			input: []byte(`{"slot":"1","parent_hash":"0x0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50"}`),
+			err:   "incorrect length for parent hash",
+		},
+		{
+			name:  "BlockHashMissing",
+			input: []byte(`{"slot":"1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50"}`),
+			err:   "block hash missing",
+		},
+		{
+			name:  "BuilderPubkeyMissing",
+			input: []byte(`{"slot":"1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50"}`),
+			err:   "builder public key missing",
+		},
+		{
+			name:  "BuilderPubkeyShort",
+			input: []byte(`{"slot":"1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x0102","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50"}`),
+			err:   "incorrect length for builder public key",
+		},
+		{
+			name:  "ProposerPubkeyMissing",
+			input: []byte(`{"slot":"1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50"}`),
+			err:   "proposer public key missing",
+		},
+		{
+			name:  "ProposerFeeRecipientMissing",
+			input: []byte(`{"slot":"1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50"}`),
+			err:   "proposer fee recipient missing",
+		},
+		{
+			name:  "ProposerFeeRecipientShort",
+			input: []byte(`{"slot":"1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x0102","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50"}`),
+			err:   "incorrect length for proposer fee recipient",
+		},
+		{
+			name:  "GasLimitMissing",
+			input: []byte(`{"slot":"1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50"}`),
+			err:   "gas limit missing",
+		},
+		{
+			name:  "GasUsedInvalid",
+			input: []byte(`{"slot":"1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"invalid","value":"1000000000000000000","block_number":"100","num_tx":"50"}`),
+			err:   "invalid value for gas used: strconv.ParseUint: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "ValueMissing",
+			input: []byte(`{"slot":"1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","block_number":"100","num_tx":"50"}`),
+			err:   "value missing",
+		},
+		{
+			name:  "ValueInvalid",
+			input: []byte(`{"slot":"1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"-1","block_number":"100","num_tx":"50"}`),
+			err:   "invalid value for value: strconv.ParseUint: parsing \"-1\": invalid syntax",
+		},
+		{
+			name:  "BlockNumberMissing",
+			input: []byte(`{"slot":"1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","num_tx":"50"}`),
+			err:   "block number missing",
+		},
+		{
+			name:  "NumTxMissing",
+			input: []byte(`{"slot":"1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100"}`),
+			err:   "number of transactions missing",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"slot":"1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50"}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res types.BidTrace
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestBidTraceYAML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		root  []byte
+		err   string
+	}{
+		{
+			name:  "Good",
+			input: []byte(`{slot: 1, parent_hash: '0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f', block_hash: '0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f', builder_pubkey: '0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f', proposer_pubkey: '0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f', proposer_fee_recipient: '0x000102030405060708090a0b0c0d0e0f10111213', gas_limit: 30000000, gas_used: 15000000, value: '1000000000000000000', block_number: 100, num_tx: 50}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res types.BidTrace
+			err := yaml.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := yaml.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, res.String(), string(rt))
+				rt = bytes.TrimSuffix(rt, []byte("\n"))
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"github.com/pkg/errors"
+)
+
+// ReceivedBidTrace represents a BidTraceV2WithTimestamp, as served by the relay data API.
+type ReceivedBidTrace struct {
+	BidTrace
+	Timestamp            time.Time
+	OptimisticSubmission bool
+}
+
+// receivedBidTraceJSON is the spec representation of the struct.
+type receivedBidTraceJSON struct {
+	Slot                 string `json:"slot"`
+	ParentHash           string `json:"parent_hash"`
+	BlockHash            string `json:"block_hash"`
+	BuilderPubkey        string `json:"builder_pubkey"`
+	ProposerPubkey       string `json:"proposer_pubkey"`
+	ProposerFeeRecipient string `json:"proposer_fee_recipient"`
+	GasLimit             string `json:"gas_limit"`
+	GasUsed              string `json:"gas_used"`
+	Value                string `json:"value"`
+	BlockNumber          string `json:"block_number"`
+	NumTx                string `json:"num_tx"`
+	Timestamp            string `json:"timestamp"`
+	TimestampMs          string `json:"timestamp_ms"`
+	OptimisticSubmission bool   `json:"optimistic_submission"`
+}
+
+// receivedBidTraceYAML is the spec representation of the struct.
+type receivedBidTraceYAML struct {
+	Slot                 uint64 `yaml:"slot"`
+	ParentHash           string `yaml:"parent_hash"`
+	BlockHash            string `yaml:"block_hash"`
+	BuilderPubkey        string `yaml:"builder_pubkey"`
+	ProposerPubkey       string `yaml:"proposer_pubkey"`
+	ProposerFeeRecipient string `yaml:"proposer_fee_recipient"`
+	GasLimit             uint64 `yaml:"gas_limit"`
+	GasUsed              uint64 `yaml:"gas_used"`
+	Value                string `yaml:"value"`
+	BlockNumber          uint64 `yaml:"block_number"`
+	NumTx                uint64 `yaml:"num_tx"`
+	Timestamp            uint64 `yaml:"timestamp"`
+	TimestampMs          uint64 `yaml:"timestamp_ms"`
+	OptimisticSubmission bool   `yaml:"optimistic_submission"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r *ReceivedBidTrace) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&receivedBidTraceJSON{
+		Slot:                 fmt.Sprintf("%d", r.Slot),
+		ParentHash:           fmt.Sprintf("%#x", r.ParentHash),
+		BlockHash:            fmt.Sprintf("%#x", r.BlockHash),
+		BuilderPubkey:        fmt.Sprintf("%#x", r.BuilderPubkey),
+		ProposerPubkey:       fmt.Sprintf("%#x", r.ProposerPubkey),
+		ProposerFeeRecipient: fmt.Sprintf("%#x", r.ProposerFeeRecipient),
+		GasLimit:             fmt.Sprintf("%d", r.GasLimit),
+		GasUsed:              fmt.Sprintf("%d", r.GasUsed),
+		Value:                r.valueString(),
+		BlockNumber:          fmt.Sprintf("%d", r.BlockNumber),
+		NumTx:                fmt.Sprintf("%d", r.NumTx),
+		Timestamp:            fmt.Sprintf("%d", r.Timestamp.Unix()),
+		TimestampMs:          fmt.Sprintf("%d", r.Timestamp.UnixMilli()),
+		OptimisticSubmission: r.OptimisticSubmission,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *ReceivedBidTrace) UnmarshalJSON(input []byte) error {
+	var data receivedBidTraceJSON
+
+	err := json.Unmarshal(input, &data)
+	if err != nil {
+		return errors.Wrap(err, "invalid JSON")
+	}
+
+	return r.unpack(&data)
+}
+
+// String returns a string version of the structure.
+func (r *ReceivedBidTrace) String() string {
+	data, err := yaml.Marshal(r)
+	if err != nil {
+		return fmt.Sprintf("ERR: %v", err)
+	}
+
+	return string(data)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (r *ReceivedBidTrace) UnmarshalYAML(input []byte) error {
+	// We unmarshal to the JSON struct to save on duplicate code.
+	var data receivedBidTraceJSON
+
+	err := yaml.Unmarshal(input, &data)
+	if err != nil {
+		return err
+	}
+
+	return r.unpack(&data)
+}
+
+// MarshalYAML implements yaml.Marshaler.
+func (r *ReceivedBidTrace) MarshalYAML() ([]byte, error) {
+	yamlBytes, err := yaml.MarshalWithOptions(&receivedBidTraceYAML{
+		Slot:                 uint64(r.Slot),
+		ParentHash:           fmt.Sprintf("%#x", r.ParentHash),
+		BlockHash:            fmt.Sprintf("%#x", r.BlockHash),
+		BuilderPubkey:        fmt.Sprintf("%#x", r.BuilderPubkey),
+		ProposerPubkey:       fmt.Sprintf("%#x", r.ProposerPubkey),
+		ProposerFeeRecipient: fmt.Sprintf("%#x", r.ProposerFeeRecipient),
+		GasLimit:             r.GasLimit,
+		GasUsed:              r.GasUsed,
+		Value:                r.valueString(),
+		BlockNumber:          r.BlockNumber,
+		NumTx:                r.NumTx,
+		Timestamp:            uint64(r.Timestamp.Unix()),
+		TimestampMs:          uint64(r.Timestamp.UnixMilli()),
+		OptimisticSubmission: r.OptimisticSubmission,
+	}, yaml.Flow(true))
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.ReplaceAll(yamlBytes, []byte(`"`), []byte(`'`)), nil
+}
+
+func (r *ReceivedBidTrace) unpack(data *receivedBidTraceJSON) error {
+	err := r.BidTrace.unpack(&bidTraceJSON{
+		Slot:                 data.Slot,
+		ParentHash:           data.ParentHash,
+		BlockHash:            data.BlockHash,
+		BuilderPubkey:        data.BuilderPubkey,
+		ProposerPubkey:       data.ProposerPubkey,
+		ProposerFeeRecipient: data.ProposerFeeRecipient,
+		GasLimit:             data.GasLimit,
+		GasUsed:              data.GasUsed,
+		Value:                data.Value,
+		BlockNumber:          data.BlockNumber,
+		NumTx:                data.NumTx,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The millisecond timestamp is more precise, so is used in preference to the timestamp.
+	timestampMs, err := unpackUint64(data.TimestampMs, "timestamp ms")
+	if err != nil {
+		return err
+	}
+
+	r.Timestamp = time.UnixMilli(int64(timestampMs))
+	r.OptimisticSubmission = data.OptimisticSubmission
+
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/attestantio/go-block-relay/types"
+	"github.com/goccy/go-yaml"
+	require "github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestReceivedBidTraceJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   string
+	}{
+		{
+			name: "Empty",
+			err:  "unexpected end of JSON input",
+		},
+		{
+			name:  "JSONBad",
+			input: []byte("[]"),
+			err:   "invalid JSON: json: cannot unmarshal array into Go value of type types.receivedBidTraceJSON",
+		},
+		{
+			name:  "SlotMissing",
+			input: []byte(`{"parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":false}`),
+			err:   "slot missing",
+		},
+		{
+			name:  "TimestampMsMissing",
+			input: []byte(`{"slot":"1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50","timestamp":"1700000000","optimistic_submission":false}`),
+			err:   "timestamp ms missing",
+		},
+		{
+			name:  "TimestampMsInvalid",
+			input: []byte(`{"slot":"1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50","timestamp":"1700000000","timestamp_ms":"invalid","optimistic_submission":false}`),
+			err:   "invalid value for timestamp ms: strconv.ParseUint: parsing \"invalid\": invalid syntax",
+		},
+		{
+			name:  "OptimisticSubmissionWrongType",
+			input: []byte(`{"slot":"1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":"true"}`),
+			err:   "invalid JSON: json: cannot unmarshal string into Go struct field receivedBidTraceJSON.optimistic_submission of type bool",
+		},
+		{
+			name:  "Good",
+			input: []byte(`{"slot":"1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":false}`),
+		},
+		{
+			name:  "GoodOptimistic",
+			input: []byte(`{"slot":"1","parent_hash":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f","block_hash":"0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f","builder_pubkey":"0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f","proposer_pubkey":"0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f","proposer_fee_recipient":"0x000102030405060708090a0b0c0d0e0f10111213","gas_limit":"30000000","gas_used":"15000000","value":"1000000000000000000","block_number":"100","num_tx":"50","timestamp":"1700000000","timestamp_ms":"1700000000123","optimistic_submission":true}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res types.ReceivedBidTrace
+			err := json.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := json.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}
+
+func TestReceivedBidTraceYAML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		root  []byte
+		err   string
+	}{
+		{
+			name:  "Good",
+			input: []byte(`{slot: 1, parent_hash: '0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f', block_hash: '0x202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f', builder_pubkey: '0x000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f', proposer_pubkey: '0x303132333435363738393a3b3c3d3e3f404142434445464748494a4b4c4d4e4f505152535455565758595a5b5c5d5e5f', proposer_fee_recipient: '0x000102030405060708090a0b0c0d0e0f10111213', gas_limit: 30000000, gas_used: 15000000, value: '1000000000000000000', block_number: 100, num_tx: 50, timestamp: 1700000000, timestamp_ms: 1700000000123, optimistic_submission: true}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var res types.ReceivedBidTrace
+			err := yaml.Unmarshal(test.input, &res)
+			if test.err != "" {
+				require.EqualError(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				rt, err := yaml.Marshal(&res)
+				require.NoError(t, err)
+				assert.Equal(t, res.String(), string(rt))
+				rt = bytes.TrimSuffix(rt, []byte("\n"))
+				assert.Equal(t, string(test.input), string(rt))
+			}
+		})
+	}
+}